@@ -15,3 +15,36 @@ type AreaFunc func(lon, lat float64) bool
 func (a AreaFunc) Contains(lon, lat float64) bool {
 	return math.Abs(lat) <= 180 && math.Abs(lat) <= 90 && (a == nil || a(lon, lat))
 }
+
+// BoundingBox is an Area of longitudes and latitudes. Boxes with a West
+// greater than the East cross the antimeridian.
+type BoundingBox struct {
+	West, South, East, North float64
+}
+
+// Contains method is the implementation of the Area interface.
+func (b BoundingBox) Contains(lon, lat float64) bool {
+	if math.Abs(lon) > 180 || lat < b.South || lat > b.North {
+		return false
+	}
+
+	if b.West > b.East {
+		return lon >= b.West || lon <= b.East
+	}
+
+	return lon >= b.West && lon <= b.East
+}
+
+// Bounds returns the BoundingBox itself.
+func (b BoundingBox) Bounds() BoundingBox {
+	return b
+}
+
+// width returns the longitudinal extent in degrees.
+func (b BoundingBox) width() float64 {
+	if b.West > b.East {
+		return b.East - b.West + 360
+	}
+
+	return b.East - b.West
+}
@@ -0,0 +1,228 @@
+//nolint:varnamelen,ireturn,exhaustivestruct,exhaustruct
+package wgs84
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Identifier is an authority-qualified code of a CoordinateReferenceSystem
+// like EPSG:25832, ESRI:102100 or IGNF:LAMB93.
+type Identifier struct {
+	Authority string
+	Code      string
+}
+
+// ParseIdentifier parses the common forms of CoordinateReferenceSystem
+// identifiers. Bare integers are EPSG-Codes.
+//
+//	4326
+//	EPSG:4326
+//	EPSG::4326
+//	urn:ogc:def:crs:EPSG::4326
+//	urn:ogc:def:crs:EPSG:9.9.1:4326
+//	urn:x-ogc:def:crs:EPSG:4326
+//	http://www.opengis.net/def/crs/EPSG/0/4326
+//	http://www.opengis.net/gml/srs/epsg.xml#4326
+//	https://epsg.io/4326
+//	https://spatialreference.org/ref/esri/102100/
+func ParseIdentifier(s string) (Identifier, error) {
+	s = strings.TrimSpace(s)
+	lower := strings.ToLower(s)
+
+	var authority, code string
+
+	switch {
+	case strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://"):
+		u, err := url.Parse(s)
+		if err != nil {
+			return Identifier{}, fmt.Errorf("%w: %s", ErrInvalidCode, s)
+		}
+
+		authority, code = httpIdentifier(u)
+	case strings.HasPrefix(lower, "urn:"):
+		parts := strings.Split(s, ":")
+		if len(parts) < 6 || !strings.EqualFold(parts[2], "def") || !strings.EqualFold(parts[3], "crs") {
+			return Identifier{}, fmt.Errorf("%w: %s", ErrInvalidCode, s)
+		}
+
+		authority, code = parts[4], parts[len(parts)-1]
+	case strings.Contains(s, ":"):
+		parts := strings.Split(s, ":")
+		authority, code = parts[0], parts[len(parts)-1]
+	default:
+		authority, code = "EPSG", s
+	}
+
+	return NewIdentifier(authority, code)
+}
+
+// NewIdentifier returns a normalized Identifier. Authorities and codes are
+// case-insensitive and EPSG-Codes must be integers.
+func NewIdentifier(authority, code string) (Identifier, error) {
+	id := Identifier{
+		Authority: strings.ToUpper(strings.TrimSpace(authority)),
+		Code:      strings.ToUpper(strings.TrimSpace(code)),
+	}
+
+	if id.Authority == "" || id.Code == "" {
+		return Identifier{}, fmt.Errorf("%w: %s:%s", ErrInvalidCode, authority, code)
+	}
+
+	if id.Authority == "EPSG" {
+		c, err := strconv.Atoi(id.Code)
+		if err != nil || c < 0 {
+			return Identifier{}, fmt.Errorf("%w: %s:%s", ErrInvalidCode, authority, code)
+		}
+
+		id.Code = strconv.Itoa(c)
+	}
+
+	return id, nil
+}
+
+// String returns the Identifier like "EPSG:4326".
+func (id Identifier) String() string {
+	return id.Authority + ":" + id.Code
+}
+
+// URN returns the Identifier like "urn:ogc:def:crs:EPSG::4326".
+func (id Identifier) URN() string {
+	return "urn:ogc:def:crs:" + id.Authority + "::" + id.Code
+}
+
+// EPSG returns the EPSG-Code of the Identifier.
+func (id Identifier) EPSG() (int, bool) {
+	if id.Authority != "EPSG" {
+		return 0, false
+	}
+
+	c, err := strconv.Atoi(id.Code)
+
+	return c, err == nil
+}
+
+func httpIdentifier(u *url.URL) (authority, code string) {
+	path := strings.Trim(u.Path, "/")
+	parts := strings.Split(path, "/")
+
+	switch {
+	case strings.HasSuffix(strings.ToLower(path), "epsg.xml"):
+		return "EPSG", u.Fragment
+	case strings.EqualFold(u.Hostname(), "epsg.io"):
+		return "EPSG", strings.TrimSuffix(parts[len(parts)-1], ".wkt")
+	}
+
+	for i, p := range parts {
+		if (strings.EqualFold(p, "crs") || strings.EqualFold(p, "ref")) && i+2 < len(parts) {
+			return parts[i+1], parts[len(parts)-1]
+		}
+	}
+
+	return "", ""
+}
+
+// AddIdentifier adds a CoordinateReferenceSystem with an authority-qualified
+// identifier to the Repository. EPSG-Codes are added like Add.
+func (r *Repository) AddIdentifier(identifier string, crs CoordinateReferenceSystem) error {
+	id, err := ParseIdentifier(identifier)
+	if err != nil {
+		return err
+	}
+
+	if c, ok := id.EPSG(); ok {
+		r.Add(c, crs)
+
+		return nil
+	}
+
+	if crs == nil {
+		return nil
+	}
+
+	r.mutex.Lock()
+	if r.identifiers == nil {
+		r.identifiers = map[Identifier]CoordinateReferenceSystem{}
+	}
+
+	r.identifiers[id] = crs
+	r.mutex.Unlock()
+
+	return nil
+}
+
+// Identifier returns the CoordinateReferenceSystem of an Identifier or nil.
+func (r *Repository) Identifier(id Identifier) CoordinateReferenceSystem {
+	if c, ok := id.EPSG(); ok {
+		return r.Code(c)
+	}
+
+	r.mutex.Lock()
+	crs := r.identifiers[id]
+	r.mutex.Unlock()
+
+	return crs
+}
+
+// Identifiers returns all available identifiers in sorted order.
+func (r *Repository) Identifiers() []Identifier {
+	codes := r.Codes()
+	ids := make([]Identifier, 0, len(codes))
+
+	for _, c := range codes {
+		ids = append(ids, Identifier{Authority: "EPSG", Code: strconv.Itoa(c)})
+	}
+
+	r.mutex.Lock()
+	for id := range r.identifiers {
+		ids = append(ids, id)
+	}
+	r.mutex.Unlock()
+
+	sort.Slice(ids, func(i, j int) bool {
+		if ids[i].Authority != ids[j].Authority {
+			return ids[i].Authority < ids[j].Authority
+		}
+
+		a, errA := strconv.Atoi(ids[i].Code)
+		b, errB := strconv.Atoi(ids[j].Code)
+
+		if errA == nil && errB == nil {
+			return a < b
+		}
+
+		return ids[i].Code < ids[j].Code
+	})
+
+	return ids
+}
+
+// cutCompound splits compound identifiers like "25832+7837",
+// "EPSG:25832+EPSG:7837", "urn:ogc:def:crs,crs:EPSG::25832,crs:EPSG::7837"
+// or "http://www.opengis.net/def/crs-compound?1=...&2=...".
+func cutCompound(code string) (horizontal, vertical string, compound bool) {
+	code = strings.TrimSpace(code)
+	lower := strings.ToLower(code)
+
+	switch {
+	case strings.HasPrefix(lower, "urn:ogc:def:crs,"):
+		parts := strings.Split(code, ",")
+		if len(parts) != 3 {
+			return code, "", false
+		}
+
+		return "urn:ogc:def:" + parts[1], "urn:ogc:def:" + parts[2], true
+	case strings.Contains(lower, "/def/crs-compound"):
+		u, err := url.Parse(code)
+		if err != nil {
+			return code, "", false
+		}
+
+		return u.Query().Get("1"), u.Query().Get("2"), true
+	}
+
+	return strings.Cut(code, "+")
+}
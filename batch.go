@@ -0,0 +1,253 @@
+//nolint:varnamelen,gomnd
+package wgs84
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"sync"
+)
+
+// ErrInvalidBuffer is returned for coordinate buffers with an invalid
+// dimension, stride or length.
+var ErrInvalidBuffer = errors.New("invalid coordinate buffer")
+
+// parallelVertices is the minimum number of vertices that are transformed
+// in parallel.
+const parallelVertices = 4096
+
+// Interleaved transforms the vertices of a flat buffer in place. Every
+// stride values a vertex starts with dim coordinates, x, y for 2 and x, y, z
+// for 3 dimensions. Other values like measures are kept and 2D vertices are
+// transformed with a third coordinate of 0.
+//
+// Large buffers are transformed in parallel, so the Func must be safe for
+// concurrent use like the Funcs of this package.
+func (f Func) Interleaved(coords []float64, dim, stride int) error {
+	if dim < 2 || dim > 3 || stride < dim || len(coords)%stride != 0 {
+		return fmt.Errorf("%w: dim %d, stride %d, length %d", ErrInvalidBuffer, dim, stride, len(coords))
+	}
+
+	parallel(len(coords)/stride, parallelVertices, func(start, end int) {
+		for i := start * stride; i < end*stride; i += stride {
+			if dim == 2 {
+				coords[i], coords[i+1], _ = f(coords[i], coords[i+1], 0)
+			} else {
+				coords[i], coords[i+1], coords[i+2] = f(coords[i], coords[i+1], coords[i+2])
+			}
+		}
+	})
+
+	return nil
+}
+
+// Separate transforms separate coordinate arrays of equal length in place.
+// The z array may be nil for 2D vertices. See Interleaved.
+func (f Func) Separate(x, y, z []float64) error {
+	if len(x) != len(y) || (z != nil && len(z) != len(x)) {
+		return fmt.Errorf("%w: lengths %d, %d, %d", ErrInvalidBuffer, len(x), len(y), len(z))
+	}
+
+	parallel(len(x), parallelVertices, func(start, end int) {
+		for i := start; i < end; i++ {
+			if z == nil {
+				x[i], y[i], _ = f(x[i], y[i], 0)
+			} else {
+				x[i], y[i], z[i] = f(x[i], y[i], z[i])
+			}
+		}
+	})
+
+	return nil
+}
+
+// Interleaved transforms the vertices of a flat buffer in place like
+// Func.Interleaved. Vertices with errors are kept and the error of the first
+// of them is returned.
+func (f SafeFunc) Interleaved(coords []float64, dim, stride int) error {
+	if dim < 2 || dim > 3 || stride < dim || len(coords)%stride != 0 {
+		return fmt.Errorf("%w: dim %d, stride %d, length %d", ErrInvalidBuffer, dim, stride, len(coords))
+	}
+
+	return safeBatch(len(coords)/stride, f, func(i int) (x, y, z *float64) {
+		if dim == 2 {
+			return &coords[i*stride], &coords[i*stride+1], nil
+		}
+
+		return &coords[i*stride], &coords[i*stride+1], &coords[i*stride+2]
+	})
+}
+
+// Separate transforms separate coordinate arrays in place like
+// Func.Separate. Vertices with errors are kept and the error of the first of
+// them is returned.
+func (f SafeFunc) Separate(x, y, z []float64) error {
+	if len(x) != len(y) || (z != nil && len(z) != len(x)) {
+		return fmt.Errorf("%w: lengths %d, %d, %d", ErrInvalidBuffer, len(x), len(y), len(z))
+	}
+
+	return safeBatch(len(x), f, func(i int) (*float64, *float64, *float64) {
+		if z == nil {
+			return &x[i], &y[i], nil
+		}
+
+		return &x[i], &y[i], &z[i]
+	})
+}
+
+// safeBatch transforms n vertices addressed by vertex and returns the error
+// of the first failed vertex.
+func safeBatch(n int, f SafeFunc, vertex func(i int) (x, y, z *float64)) error {
+	var (
+		mutex sync.Mutex
+		first = n
+		err   error
+	)
+
+	parallel(n, parallelVertices, func(start, end int) {
+		for i := start; i < end; i++ {
+			x, y, z := vertex(i)
+
+			c := 0.0
+			if z != nil {
+				c = *z
+			}
+
+			a, b, c, e := f(*x, *y, c)
+			if e != nil {
+				mutex.Lock()
+				if i < first {
+					first, err = i, e
+				}
+				mutex.Unlock()
+
+				continue
+			}
+
+			*x, *y = a, b
+			if z != nil {
+				*z = c
+			}
+		}
+	})
+
+	if err != nil {
+		return fmt.Errorf("%w: vertex %d", err, first)
+	}
+
+	return nil
+}
+
+// parallel calls fn for chunks of n items on all processors if n is at least
+// the threshold.
+func parallel(n, threshold int, fn func(start, end int)) {
+	workers := runtime.GOMAXPROCS(0)
+	if n < threshold || workers < 2 {
+		fn(0, n)
+
+		return
+	}
+
+	var wg sync.WaitGroup
+
+	chunk := (n + workers - 1) / workers
+
+	for start := 0; start < n; start += chunk {
+		end := start + chunk
+		if end > n {
+			end = n
+		}
+
+		wg.Add(1)
+
+		go func(start, end int) {
+			defer wg.Done()
+
+			fn(start, end)
+		}(start, end)
+	}
+
+	wg.Wait()
+}
+
+// preparer is implemented by Projections with constants that can be
+// computed once for a Spheroid.
+type preparer interface {
+	prepare(s Spheroid) Projection
+}
+
+// prepare returns a CoordinateReferenceSystem with the constants of its
+// Projection computed for its Datum.
+func prepare(crs CoordinateReferenceSystem) CoordinateReferenceSystem {
+	switch v := crs.(type) {
+	case ProjectedReferenceSystem:
+		if p, ok := v.Projection.(preparer); ok {
+			v.Projection = p.prepare(v.Datum)
+		}
+
+		return v
+	case LocalReferenceSystem:
+		if p, ok := v.Base.Projection.(preparer); ok {
+			v.Base.Projection = p.prepare(v.Base.Datum)
+		}
+
+		return v
+	case CompoundReferenceSystem:
+		if v.Horizontal != nil {
+			v.Horizontal = prepare(v.Horizontal)
+		}
+
+		return v
+	}
+
+	return crs
+}
+
+// direct returns a Func between geographic and projected systems on the
+// same Spheroid without datum transformations, which skips the conversion to
+// geocentric coordinates. Latitudes beyond the poles take the general path.
+func direct(from, to CoordinateReferenceSystem) (Func, bool) {
+	fromDatum, toLonLat, okFrom := directSystem(from)
+	toDatum, fromLonLat, okTo := directSystem(to)
+
+	if !okFrom || !okTo || fromDatum.Transformation != nil || toDatum.Transformation != nil ||
+		fromDatum.Body != toDatum.Body || fromDatum.A() != toDatum.A() || fromDatum.Fi() != toDatum.Fi() {
+		return nil, false
+	}
+
+	return func(a, b, c float64) (a2, b2, c2 float64) {
+		lon, lat := toLonLat.ToLonLat(a, b, fromDatum)
+		if math.Abs(lat) > 90 {
+			return to.FromWGS84(from.ToWGS84(a, b, c))
+		}
+
+		a2, b2 = fromLonLat.FromLonLat(wrapLon(lon), lat, toDatum)
+
+		return a2, b2, c
+	}, true
+}
+
+// directSystem returns the Datum and the Projection of geographic and
+// projected systems.
+func directSystem(crs CoordinateReferenceSystem) (Datum, Projection, bool) {
+	switch v := crs.(type) {
+	case GeographicReferenceSystem:
+		return v.Datum, geographic{}, true
+	case ProjectedReferenceSystem:
+		return v.Datum, v.Projection, v.Projection != nil
+	}
+
+	return Datum{}, nil, false
+}
+
+// geographic is the identity Projection of geographic coordinates.
+type geographic struct{}
+
+func (geographic) ToLonLat(lon, lat float64, _ Spheroid) (float64, float64) {
+	return lon, lat
+}
+
+func (geographic) FromLonLat(lon, lat float64, _ Spheroid) (float64, float64) {
+	return lon, lat
+}
@@ -0,0 +1,97 @@
+//nolint:varnamelen
+package wgs84_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestBatch(t *testing.T) {
+	t.Parallel()
+
+	epsg := wgs84.EPSG()
+
+	east, north, _ := epsg.Transform(4326, 2154).Round(3)(3, 46.5, 0)
+	if east != 700000 || north != 6600000 {
+		t.Fatal("Failed", east, north)
+	}
+
+	east, north, _ = wgs84.Transform(wgs84.LonLat(), wgs84.UTM(32, true)).Round(3)(9, 0, 0)
+	if east != 500000 || north != 0 {
+		t.Fatal("Failed (2)", east, north)
+	}
+
+	if lon, lat, _ := wgs84.Transform(wgs84.LonLat(), wgs84.LonLat())(190, 50, 0); lon != -170 || lat != 50 {
+		t.Fatal("Failed (3)", lon, lat)
+	}
+
+	f := epsg.Transform(4326, 25832)
+
+	coords := make([]float64, 4*5000)
+	for i := 0; i < len(coords); i += 4 {
+		coords[i], coords[i+1], coords[i+2], coords[i+3] = 6+float64(i%400)/100, 48+float64(i%700)/100, 100, 42
+	}
+
+	want := append([]float64(nil), coords...)
+	for i := 0; i < len(want); i += 4 {
+		want[i], want[i+1], want[i+2] = f(want[i], want[i+1], want[i+2])
+	}
+
+	if err := f.Interleaved(coords, 3, 4); err != nil {
+		t.Fatal("Failed (4)", err)
+	}
+
+	for i := range coords {
+		if coords[i] != want[i] {
+			t.Fatal("Failed (5)", i, coords[i], want[i])
+		}
+	}
+
+	xy := []float64{9, 50, 10, 51}
+	if err := f.Interleaved(xy, 2, 2); err != nil {
+		t.Fatal("Failed (6)", err)
+	}
+
+	x, y := []float64{9, 10}, []float64{50, 51}
+	if err := f.Separate(x, y, nil); err != nil {
+		t.Fatal("Failed (7)", err)
+	}
+
+	if x[0] != xy[0] || y[0] != xy[1] || x[1] != xy[2] || y[1] != xy[3] {
+		t.Fatal("Failed (8)", x, y, xy)
+	}
+
+	reprojected := wgs84.ReprojectSlice([][]float64{{9, 50}, {10, 51}}, 4326, 25832)
+	if reprojected[0][0] != xy[0] || reprojected[1][1] != xy[3] {
+		t.Fatal("Failed (9)", reprojected)
+	}
+
+	for _, c := range []struct{ length, dim, stride int }{{4, 1, 2}, {4, 4, 4}, {4, 3, 2}, {5, 2, 2}} {
+		if err := f.Interleaved(make([]float64, c.length), c.dim, c.stride); !errors.Is(err, wgs84.ErrInvalidBuffer) {
+			t.Fatal("Failed (10)", c, err)
+		}
+	}
+
+	if err := f.Separate(x, y[:1], nil); !errors.Is(err, wgs84.ErrInvalidBuffer) {
+		t.Fatal("Failed (11)", err)
+	}
+
+	safe := epsg.SafeTransform(4326, 25832)
+
+	x, y = []float64{9, 100, 10}, []float64{50, 50, 51}
+	if err := safe.Separate(x, y, nil); !errors.Is(err, wgs84.ErrOutOfBounds) || err.Error() != "coordinate is out of bounds: vertex 1" {
+		t.Fatal("Failed (12)", err)
+	}
+
+	if x[0] != xy[0] || x[1] != 100 || y[1] != 50 || y[2] != xy[3] {
+		t.Fatal("Failed (13)", x, y)
+	}
+
+	xyz := []float64{9, 50, 0, 10, 51, 0}
+	if err := safe.Interleaved(xyz, 3, 3); err != nil || math.Abs(xyz[0]-xy[0]) > 1e-9 || xyz[4] != xy[3] {
+		t.Fatal("Failed (14)", xyz, err)
+	}
+}
@@ -0,0 +1,107 @@
+//nolint:gomnd
+package wgs84
+
+import "math"
+
+// Body identifies the celestial body of a Datum.
+//
+// It implements the Spheroid interface with the radii of the IAU Working
+// Group on Cartographic Coordinates and Rotational Elements 2015. Bodies
+// without a flattening are spheres with an infinite inverse flattening.
+type Body int
+
+const (
+	// Earth is the default Body.
+	Earth Body = iota
+	Mercury
+	Venus
+	Moon
+	Mars
+	Jupiter
+	Saturn
+	Uranus
+	Neptune
+	Pluto
+)
+
+// bodyRadii are the equatorial and polar radii in meters.
+//
+//nolint:gochecknoglobals
+var bodyRadii = map[Body][2]float64{
+	Earth:   {6378136.6, 6356751.9},
+	Mercury: {2440530, 2438260},
+	Venus:   {6051800, 6051800},
+	Moon:    {1737400, 1737400},
+	Mars:    {3396190, 3376200},
+	Jupiter: {71492000, 66854000},
+	Saturn:  {60268000, 54364000},
+	Uranus:  {25559000, 24973000},
+	Neptune: {24764000, 24341000},
+	Pluto:   {1188300, 1188300},
+}
+
+// String returns the name of the Body.
+func (b Body) String() string {
+	switch b {
+	case Earth:
+		return "Earth"
+	case Mercury:
+		return "Mercury"
+	case Venus:
+		return "Venus"
+	case Moon:
+		return "Moon"
+	case Mars:
+		return "Mars"
+	case Jupiter:
+		return "Jupiter"
+	case Saturn:
+		return "Saturn"
+	case Uranus:
+		return "Uranus"
+	case Neptune:
+		return "Neptune"
+	case Pluto:
+		return "Pluto"
+	}
+
+	return "Unknown"
+}
+
+// A returns the equatorial radius of the Body.
+func (b Body) A() float64 {
+	return bodyRadii[b][0]
+}
+
+// Fi returns the inverse flattening of the Body.
+func (b Body) Fi() float64 {
+	r := bodyRadii[b]
+	if r[0] == r[1] {
+		return math.Inf(1)
+	}
+
+	return r[0] / (r[0] - r[1])
+}
+
+// westPositive reports whether planetographic longitudes increase to the
+// west, which is the case for prograde rotating bodies except the Earth and
+// the Moon.
+func (b Body) westPositive() bool {
+	switch b {
+	case Mercury, Mars, Jupiter, Saturn, Neptune:
+		return true
+	case Earth, Venus, Moon, Uranus, Pluto:
+	}
+
+	return false
+}
+
+// bodyOf returns the Body of a CoordinateReferenceSystem. It is the Earth if
+// the CoordinateReferenceSystem has no Body method.
+func bodyOf(crs CoordinateReferenceSystem) Body {
+	if b, ok := crs.(interface{ Body() Body }); ok {
+		return b.Body()
+	}
+
+	return Earth
+}
@@ -0,0 +1,2621 @@
+//nolint:gomnd,gochecknoglobals
+package wgs84
+
+import "math"
+
+// The boundaries are the outlines of Natural Earth 1:10m Admin 0 - Countries
+// (version 5.1.2, public domain, https://www.naturalearthdata.com),
+// simplified by the Douglas-Peucker algorithm with a tolerance of 0.01° at
+// the coasts and 0.002° at the land borders and rounded to 0.001°. Shared
+// land borders are simplified alike, so neighbouring boundaries meet.
+// Overseas territories are left out.
+//
+// The outlines are off by up to a kilometer at this scale and the
+// simplification cuts off up to another kilometer, so coordinates within
+// boundaryBuffer of an outline are contained as well. This keeps coastal
+// cities and islands inside and extends the land borders into the
+// neighbouring countries by the same distance.
+//
+// The other built-in Datums keep the bounding boxes of their EPSG extents.
+// These include offshore areas and remote islands that are not part of the
+// land outlines.
+
+// boundaryBuffer is the distance in kilometers around the outlines that
+// belongs to a boundary.
+const boundaryBuffer = 2.5
+
+// kmPerDegree is the length of a degree of latitude in kilometers.
+const kmPerDegree = 111.32
+
+// boundary is a MultiPolygon with the bounds of its Polygons widened by
+// boundaryBuffer, so that only Polygons around a coordinate are tested.
+type boundary struct {
+	polygons MultiPolygon
+	bounds   []BoundingBox
+	union    BoundingBox
+}
+
+func newBoundary(m MultiPolygon) *boundary {
+	b := &boundary{polygons: m, bounds: make([]BoundingBox, len(m))}
+
+	for i, p := range m {
+		bounds := p.Bounds()
+		dLat := boundaryBuffer / kmPerDegree
+		dLon := dLat / math.Cos(radian(math.Max(math.Abs(bounds.South), math.Abs(bounds.North))+dLat))
+
+		b.bounds[i] = BoundingBox{
+			West:  bounds.West - dLon,
+			South: bounds.South - dLat,
+			East:  bounds.East + dLon,
+			North: bounds.North + dLat,
+		}
+	}
+
+	b.union = unionBounds(b.bounds)
+
+	return b
+}
+
+// Contains method is the implementation of the Area interface.
+func (b *boundary) Contains(lon, lat float64) bool {
+	for i, p := range b.polygons {
+		if b.bounds[i].Contains(lon, lat) && (p.Contains(lon, lat) || nearRings(p, lon, lat)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Bounds returns the smallest BoundingBox of all widened Polygons.
+func (b *boundary) Bounds() BoundingBox {
+	return b.union
+}
+
+// nearRings reports whether a coordinate is within boundaryBuffer of an edge
+// of the Polygon. The distances are measured on a plane tangent at the
+// coordinate.
+func nearRings(p Polygon, lon, lat float64) bool {
+	kx, ky := math.Cos(radian(lat))*kmPerDegree, kmPerDegree
+
+	for _, ring := range p {
+		for i := range ring {
+			a, c := ring[i], ring[(i+1)%len(ring)]
+			ax, ay := wrapLon(a[0]-lon)*kx, (a[1]-lat)*ky
+			dx, dy := wrapLon(c[0]-a[0])*kx, (c[1]-a[1])*ky
+
+			t := 0.0
+			if l := dx*dx + dy*dy; l > 0 {
+				t = math.Max(0, math.Min(1, -(ax*dx+ay*dy)/l))
+			}
+
+			if x, y := ax+t*dx, ay+t*dy; x*x+y*y <= boundaryBuffer*boundaryBuffer {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// germany is Germany.
+var germany = newBoundary(MultiPolygon{
+	{
+		{
+			{13.816, 48.766}, {13.786, 48.725}, {13.784, 48.715}, {13.789, 48.717}, {13.799, 48.703},
+			{13.817, 48.696}, {13.804, 48.687}, {13.801, 48.675}, {13.806, 48.643}, {13.802, 48.612},
+			{13.796, 48.599}, {13.774, 48.569}, {13.758, 48.561}, {13.734, 48.56}, {13.725, 48.548},
+			{13.717, 48.522}, {13.673, 48.535}, {13.658, 48.551}, {13.624, 48.565}, {13.521, 48.585},
+			{13.487, 48.582}, {13.455, 48.573}, {13.44, 48.561}, {13.448, 48.535}, {13.457, 48.525},
+			{13.459, 48.516}, {13.456, 48.507}, {13.438, 48.479}, {13.436, 48.431}, {13.427, 48.419},
+			{13.42, 48.392}, {13.406, 48.377}, {13.308, 48.32}, {13.274, 48.307}, {13.136, 48.291},
+			{13.033, 48.264}, {12.979, 48.232}, {12.932, 48.212}, {12.933, 48.209}, {12.878, 48.202},
+			{12.863, 48.197}, {12.822, 48.161}, {12.782, 48.142}, {12.764, 48.124}, {12.745, 48.121},
+			{12.739, 48.113}, {12.737, 48.1}, {12.742, 48.087}, {12.76, 48.065}, {12.831, 48.015},
+			{12.846, 47.993}, {12.854, 47.97}, {12.862, 47.963}, {12.886, 47.953}, {12.931, 47.925},
+			{12.965, 47.872}, {12.991, 47.847}, {12.927, 47.777}, {12.921, 47.77}, {12.917, 47.75},
+			{12.892, 47.724}, {12.908, 47.712}, {12.964, 47.705}, {13.004, 47.715}, {13.02, 47.713},
+			{13.045, 47.697}, {13.064, 47.674}, {13.072, 47.659}, {13.075, 47.647}, {13.072, 47.622},
+			{13.057, 47.598}, {13.041, 47.583}, {13.038, 47.584}, {13.039, 47.561}, {13.041, 47.562},
+			{13.028, 47.542}, {13.029, 47.518}, {13.037, 47.501}, {13.037, 47.493}, {13.002, 47.466},
+			{12.991, 47.466}, {12.97, 47.476}, {12.943, 47.47}, {12.932, 47.474}, {12.883, 47.499},
+			{12.828, 47.537}, {12.804, 47.541}, {12.779, 47.555}, {12.774, 47.58}, {12.786, 47.603},
+			{12.813, 47.612}, {12.793, 47.625}, {12.767, 47.636}, {12.752, 47.649}, {12.762, 47.667},
+			{12.745, 47.665}, {12.689, 47.675}, {12.653, 47.675}, {12.617, 47.669}, {12.598, 47.663},
+			{12.554, 47.636}, {12.538, 47.631}, {12.497, 47.629}, {12.483, 47.634}, {12.445, 47.656},
+			{12.424, 47.692}, {12.408, 47.694}, {12.351, 47.682}, {12.294, 47.69}, {12.272, 47.688},
+			{12.239, 47.679}, {12.229, 47.685}, {12.223, 47.696}, {12.233, 47.709}, {12.242, 47.732},
+			{12.225, 47.727}, {12.192, 47.71}, {12.177, 47.706}, {12.182, 47.692}, {12.202, 47.678},
+			{12.205, 47.672}, {12.207, 47.646}, {12.202, 47.63}, {12.174, 47.605}, {11.936, 47.611},
+			{11.852, 47.599}, {11.841, 47.595}, {11.831, 47.578}, {11.82, 47.575}, {11.764, 47.583},
+			{11.683, 47.583}, {11.62, 47.59}, {11.589, 47.57}, {11.569, 47.527}, {11.551, 47.514},
+			{11.53, 47.508}, {11.483, 47.503}, {11.435, 47.51}, {11.413, 47.506}, {11.365, 47.469},
+			{11.389, 47.462}, {11.392, 47.455}, {11.384, 47.445}, {11.368, 47.44}, {11.324, 47.439},
+			{11.306, 47.435}, {11.259, 47.401}, {11.237, 47.394}, {11.213, 47.396}, {11.215, 47.423},
+			{11.194, 47.429}, {11.169, 47.424}, {11.103, 47.394}, {11.084, 47.39}, {10.979, 47.391},
+			{10.966, 47.396}, {10.955, 47.41}, {10.963, 47.421}, {10.96, 47.433}, {10.91, 47.469},
+			{10.858, 47.485}, {10.852, 47.493}, {10.884, 47.508}, {10.892, 47.515}, {10.859, 47.531},
+			{10.845, 47.531}, {10.79, 47.516}, {10.761, 47.514}, {10.752, 47.515}, {10.74, 47.528},
+			{10.608, 47.562}, {10.584, 47.562}, {10.57, 47.556}, {10.55, 47.537}, {10.537, 47.53},
+			{10.525, 47.528}, {10.483, 47.533}, {10.467, 47.538}, {10.454, 47.546}, {10.452, 47.555},
+			{10.462, 47.57}, {10.457, 47.579}, {10.454, 47.581}, {10.429, 47.577}, {10.414, 47.573},
+			{10.416, 47.564}, {10.431, 47.542}, {10.42, 47.493}, {10.434, 47.488}, {10.448, 47.473},
+			{10.452, 47.46}, {10.452, 47.439}, {10.443, 47.416}, {10.428, 47.396}, {10.412, 47.381},
+			{10.372, 47.367}, {10.343, 47.331}, {10.325, 47.314}, {10.306, 47.302}, {10.261, 47.283},
+			{10.239, 47.278}, {10.16, 47.271}, {10.155, 47.273}, {10.169, 47.29}, {10.191, 47.298},
+			{10.193, 47.305}, {10.19, 47.317}, {10.209, 47.354}, {10.209, 47.372}, {10.191, 47.379},
+			{10.147, 47.374}, {10.131, 47.363}, {10.11, 47.367}, {10.083, 47.359}, {10.073, 47.365},
+			{10.068, 47.375}, {10.064, 47.396}, {10.053, 47.405}, {10.076, 47.417}, {10.081, 47.427},
+			{10.072, 47.439}, {10.023, 47.488}, {9.994, 47.477}, {9.983, 47.481}, {9.981, 47.493},
+			{9.972, 47.506}, {9.949, 47.524}, {9.946, 47.541}, {9.934, 47.534}, {9.919, 47.532},
+			{9.889, 47.534}, {9.859, 47.541}, {9.833, 47.535}, {9.813, 47.542}, {9.809, 47.552},
+			{9.811, 47.564}, {9.808, 47.576}, {9.796, 47.585}, {9.782, 47.588}, {9.767, 47.587},
+			{9.753, 47.582}, {9.731, 47.565}, {9.704, 47.531}, {9.677, 47.523}, {9.613, 47.522},
+			{9.55, 47.534}, {9.547, 47.535}, {9.273, 47.65}, {9.234, 47.656}, {9.197, 47.656},
+			{9.183, 47.67}, {9.128, 47.67}, {9.017, 47.679}, {8.998, 47.674}, {8.982, 47.662},
+			{8.945, 47.654}, {8.906, 47.652}, {8.882, 47.656}, {8.838, 47.681}, {8.838, 47.688},
+			{8.856, 47.691}, {8.83, 47.707}, {8.798, 47.72}, {8.771, 47.721}, {8.762, 47.701},
+			{8.77, 47.695}, {8.717, 47.695}, {8.713, 47.709}, {8.7, 47.723}, {8.72, 47.747}, {8.713, 47.757},
+			{8.682, 47.759}, {8.657, 47.788}, {8.644, 47.791}, {8.635, 47.785}, {8.63, 47.763},
+			{8.617, 47.757}, {8.608, 47.762}, {8.602, 47.795}, {8.583, 47.8}, {8.558, 47.801},
+			{8.542, 47.795}, {8.552, 47.779}, {8.537, 47.774}, {8.472, 47.767}, {8.464, 47.764},
+			{8.445, 47.743}, {8.438, 47.723}, {8.402, 47.707}, {8.392, 47.7}, {8.391, 47.692},
+			{8.398, 47.676}, {8.391, 47.665}, {8.412, 47.661}, {8.458, 47.64}, {8.476, 47.64},
+			{8.52, 47.657}, {8.568, 47.663}, {8.607, 47.656}, {8.602, 47.633}, {8.595, 47.635},
+			{8.594, 47.643}, {8.58, 47.639}, {8.578, 47.633}, {8.583, 47.622}, {8.581, 47.6},
+			{8.574, 47.592}, {8.561, 47.589}, {8.538, 47.612}, {8.522, 47.622}, {8.492, 47.62},
+			{8.462, 47.606}, {8.449, 47.584}, {8.418, 47.581}, {8.354, 47.581}, {8.306, 47.592},
+			{8.289, 47.616}, {8.251, 47.622}, {8.233, 47.622}, {8.179, 47.616}, {8.162, 47.604},
+			{8.144, 47.6}, {8.114, 47.588}, {8.097, 47.572}, {8.087, 47.567}, {8.042, 47.561},
+			{7.912, 47.561}, {7.904, 47.584}, {7.898, 47.588}, {7.834, 47.59}, {7.82, 47.595},
+			{7.786, 47.563}, {7.767, 47.556}, {7.683, 47.544}, {7.661, 47.546}, {7.61, 47.565},
+			{7.636, 47.565}, {7.647, 47.572}, {7.66, 47.597}, {7.637, 47.595}, {7.586, 47.585},
+			{7.59, 47.595}, {7.592, 47.598}, {7.59, 47.608}, {7.573, 47.622}, {7.55, 47.652},
+			{7.537, 47.665}, {7.522, 47.67}, {7.518, 47.675}, {7.512, 47.707}, {7.538, 47.732},
+			{7.538, 47.748}, {7.526, 47.783}, {7.529, 47.797}, {7.543, 47.829}, {7.562, 47.839},
+			{7.564, 47.85}, {7.559, 47.869}, {7.559, 47.883}, {7.579, 47.906}, {7.58, 47.927},
+			{7.585, 47.94}, {7.612, 47.959}, {7.621, 47.971}, {7.619, 48.003}, {7.576, 48.054},
+			{7.573, 48.095}, {7.586, 48.13}, {7.605, 48.153}, {7.607, 48.167}, {7.613, 48.179},
+			{7.677, 48.238}, {7.687, 48.253}, {7.702, 48.311}, {7.737, 48.327}, {7.751, 48.341},
+			{7.752, 48.355}, {7.74, 48.381}, {7.737, 48.393}, {7.738, 48.407}, {7.751, 48.444},
+			{7.769, 48.47}, {7.771, 48.489}, {7.776, 48.498}, {7.813, 48.519}, {7.813, 48.526},
+			{7.802, 48.548}, {7.802, 48.582}, {7.81, 48.615}, {7.852, 48.659}, {7.881, 48.668},
+			{7.896, 48.677}, {7.959, 48.721}, {7.964, 48.729}, {7.965, 48.748}, {7.97, 48.757},
+			{8.017, 48.762}, {8.023, 48.765}, {8.027, 48.78}, {8.039, 48.791}, {8.048, 48.791},
+			{8.09, 48.808}, {8.103, 48.821}, {8.148, 48.904}, {8.179, 48.943}, {8.2, 48.959},
+			{8.189, 48.966}, {8.09, 48.979}, {7.932, 49.035}, {7.857, 49.032}, {7.774, 49.048},
+			{7.727, 49.036}, {7.685, 49.043}, {7.649, 49.036}, {7.635, 49.038}, {7.626, 49.043},
+			{7.609, 49.062}, {7.586, 49.07}, {7.543, 49.075}, {7.521, 49.084}, {7.485, 49.119},
+			{7.471, 49.128}, {7.483, 49.137}, {7.476, 49.152}, {7.458, 49.156}, {7.415, 49.158},
+			{7.41, 49.169}, {7.391, 49.17}, {7.351, 49.159}, {7.334, 49.134}, {7.297, 49.128},
+			{7.274, 49.105}, {7.241, 49.114}, {7.155, 49.114}, {7.124, 49.123}, {7.08, 49.142},
+			{7.071, 49.136}, {7.075, 49.125}, {7.073, 49.12}, {7.043, 49.108}, {7.021, 49.119},
+			{7.009, 49.146}, {7.009, 49.182}, {6.988, 49.182}, {6.925, 49.206}, {6.914, 49.207},
+			{6.885, 49.205}, {6.843, 49.211}, {6.833, 49.209}, {6.828, 49.196}, {6.839, 49.163},
+			{6.822, 49.148}, {6.809, 49.146}, {6.77, 49.155}, {6.726, 49.156}, {6.714, 49.159},
+			{6.701, 49.173}, {6.709, 49.198}, {6.703, 49.214}, {6.681, 49.207}, {6.664, 49.244},
+			{6.64, 49.253}, {6.64, 49.271}, {6.556, 49.326}, {6.55, 49.338}, {6.556, 49.347},
+			{6.573, 49.348}, {6.578, 49.356}, {6.574, 49.362}, {6.519, 49.402}, {6.521, 49.408},
+			{6.512, 49.425}, {6.495, 49.436}, {6.403, 49.465}, {6.392, 49.467}, {6.354, 49.455},
+			{6.345, 49.455}, {6.345, 49.463}, {6.342, 49.493}, {6.351, 49.535}, {6.351, 49.567},
+			{6.356, 49.578}, {6.398, 49.614}, {6.418, 49.639}, {6.417, 49.646}, {6.403, 49.656},
+			{6.418, 49.67}, {6.492, 49.701}, {6.483, 49.708}, {6.486, 49.713}, {6.5, 49.712},
+			{6.488, 49.726}, {6.486, 49.734}, {6.486, 49.743}, {6.496, 49.748}, {6.494, 49.764},
+			{6.5, 49.794}, {6.503, 49.796}, {6.497, 49.799}, {6.462, 49.805}, {6.415, 49.805},
+			{6.397, 49.809}, {6.334, 49.84}, {6.303, 49.835}, {6.287, 49.861}, {6.238, 49.877},
+			{6.223, 49.887}, {6.19, 49.938}, {6.173, 49.956}, {6.162, 49.942}, {6.154, 49.951},
+			{6.139, 49.979}, {6.122, 49.996}, {6.117, 50.004}, {6.12, 50.016}, {6.114, 50.036},
+			{6.107, 50.045}, {6.096, 50.049}, {6.109, 50.064}, {6.099, 50.064}, {6.111, 50.106},
+			{6.117, 50.12}, {6.127, 50.127}, {6.129, 50.134}, {6.126, 50.14}, {6.116, 50.145},
+			{6.123, 50.165}, {6.132, 50.168}, {6.152, 50.169}, {6.159, 50.172}, {6.165, 50.182},
+			{6.166, 50.193}, {6.16, 50.205}, {6.147, 50.214}, {6.157, 50.223}, {6.18, 50.233},
+			{6.19, 50.243}, {6.253, 50.257}, {6.272, 50.267}, {6.267, 50.276}, {6.269, 50.286},
+			{6.286, 50.304}, {6.299, 50.309}, {6.335, 50.304}, {6.362, 50.307}, {6.375, 50.315},
+			{6.372, 50.329}, {6.338, 50.368}, {6.336, 50.38}, {6.35, 50.417}, {6.351, 50.434},
+			{6.34, 50.438}, {6.332, 50.447}, {6.323, 50.466}, {6.326, 50.474}, {6.337, 50.481},
+			{6.275, 50.488}, {6.256, 50.495}, {6.207, 50.486}, {6.183, 50.507}, {6.191, 50.515},
+			{6.171, 50.518}, {6.169, 50.522}, {6.177, 50.53}, {6.161, 50.544}, {6.179, 50.562},
+			{6.233, 50.587}, {6.233, 50.591}, {6.251, 50.608}, {6.249, 50.614}, {6.18, 50.616},
+			{6.159, 50.622}, {6.148, 50.637}, {6.161, 50.642}, {6.1, 50.701}, {6.081, 50.713},
+			{6.064, 50.714}, {6.025, 50.708}, {6.012, 50.709}, {6.008, 50.717}, {6.011, 50.727},
+			{6.011, 50.737}, {5.994, 50.743}, {5.993, 50.748}, {5.995, 50.75}, {5.997, 50.763},
+			{5.973, 50.782}, {5.969, 50.795}, {5.984, 50.792}, {5.998, 50.801}, {6.004, 50.813}, {6, 50.83},
+			{6.009, 50.84}, {6.023, 50.845}, {6.046, 50.844}, {6.057, 50.853}, {6.063, 50.863},
+			{6.063, 50.871}, {6.055, 50.891}, {6.064, 50.908}, {6.031, 50.915}, {6, 50.929}, {5.996, 50.952},
+			{6.006, 50.969}, {6.004, 50.974}, {5.968, 50.971}, {5.928, 50.978}, {5.875, 50.965},
+			{5.874, 50.991}, {5.858, 51.019}, {5.852, 51.043}, {5.88, 51.052}, {5.894, 51.047},
+			{5.92, 51.03}, {5.938, 51.031}, {5.95, 51.039}, {5.969, 51.065}, {5.983, 51.075}, {6.075, 51.12},
+			{6.132, 51.14}, {6.147, 51.152}, {6.126, 51.165}, {6.157, 51.179}, {6.134, 51.184},
+			{6.084, 51.163}, {6.061, 51.171}, {6.054, 51.189}, {6.057, 51.212}, {6.065, 51.232},
+			{6.097, 51.259}, {6.148, 51.318}, {6.184, 51.336}, {6.194, 51.345}, {6.199, 51.357},
+			{6.202, 51.379}, {6.208, 51.388}, {6.193, 51.399}, {6.194, 51.417}, {6.205, 51.458},
+			{6.193, 51.509}, {6.153, 51.538}, {6.086, 51.596}, {6.082, 51.608}, {6.099, 51.644},
+			{6.031, 51.663}, {6.009, 51.681}, {6.022, 51.71}, {6.012, 51.716}, {5.978, 51.73},
+			{5.939, 51.732}, {5.94, 51.743}, {5.962, 51.756}, {5.964, 51.777}, {5.951, 51.796},
+			{5.928, 51.807}, {5.931, 51.816}, {5.948, 51.823}, {5.989, 51.827}, {6.008, 51.833},
+			{6.043, 51.847}, {6.084, 51.854}, {6.156, 51.842}, {6.143, 51.855}, {6.093, 51.885},
+			{6.127, 51.897}, {6.159, 51.887}, {6.191, 51.871}, {6.225, 51.863}, {6.265, 51.866},
+			{6.287, 51.846}, {6.326, 51.843}, {6.342, 51.837}, {6.345, 51.821}, {6.382, 51.828},
+			{6.387, 51.838}, {6.378, 51.86}, {6.443, 51.848}, {6.463, 51.848}, {6.479, 51.853},
+			{6.529, 51.876}, {6.628, 51.898}, {6.717, 51.899}, {6.744, 51.908}, {6.767, 51.926},
+			{6.79, 51.951}, {6.811, 51.961}, {6.809, 51.98}, {6.778, 52.001}, {6.675, 52.035},
+			{6.673, 52.05}, {6.68, 52.06}, {6.711, 52.067}, {6.733, 52.092}, {6.75, 52.103}, {6.766, 52.108},
+			{6.831, 52.113}, {6.843, 52.12}, {6.865, 52.148}, {6.885, 52.16}, {6.928, 52.172},
+			{6.948, 52.183}, {6.982, 52.214}, {7.026, 52.231}, {7.029, 52.236}, {7.021, 52.245},
+			{7.012, 52.267}, {7.012, 52.285}, {7.04, 52.329}, {7.048, 52.365}, {7.034, 52.391},
+			{6.973, 52.451}, {6.951, 52.437}, {6.9, 52.432}, {6.872, 52.435}, {6.82, 52.447},
+			{6.741, 52.454}, {6.715, 52.462}, {6.695, 52.476}, {6.689, 52.491}, {6.684, 52.526},
+			{6.672, 52.542}, {6.688, 52.543}, {6.744, 52.56}, {6.704, 52.583}, {6.704, 52.591},
+			{6.71, 52.609}, {6.702, 52.619}, {6.737, 52.635}, {6.771, 52.641}, {6.865, 52.642},
+			{6.918, 52.632}, {6.968, 52.637}, {7.018, 52.626}, {7.037, 52.647}, {7.044, 52.683},
+			{7.053, 52.791}, {7.062, 52.824}, {7.08, 52.854}, {7.162, 52.933}, {7.184, 52.966},
+			{7.193, 52.998}, {7.194, 53.034}, {7.185, 53.105}, {7.173, 53.126}, {7.172, 53.138},
+			{7.195, 53.185}, {7.199, 53.201}, {7.195, 53.245}, {7.204, 53.248}, {7.255, 53.32},
+			{7.367, 53.303}, {7.303, 53.333}, {7.038, 53.349}, {7.024, 53.376}, {7.023, 53.451},
+			{7.06, 53.522}, {7.12, 53.516}, {7.142, 53.537}, {7.087, 53.587}, {7.226, 53.666},
+			{7.305, 53.685}, {7.459, 53.695}, {7.504, 53.679}, {7.951, 53.722}, {8.031, 53.708},
+			{8.025, 53.673}, {8.053, 53.636}, {8.128, 53.591}, {8.119, 53.572}, {8.168, 53.553},
+			{8.155, 53.526}, {8.059, 53.502}, {8.077, 53.469}, {8.1, 53.447}, {8.152, 53.45},
+			{8.205, 53.411}, {8.259, 53.412}, {8.315, 53.475}, {8.32, 53.509}, {8.292, 53.529},
+			{8.231, 53.525}, {8.249, 53.59}, {8.271, 53.613}, {8.329, 53.618}, {8.388, 53.577},
+			{8.552, 53.544}, {8.49, 53.486}, {8.485, 53.401}, {8.504, 53.358}, {8.498, 53.475},
+			{8.557, 53.518}, {8.566, 53.547}, {8.508, 53.625}, {8.486, 53.7}, {8.573, 53.858},
+			{8.652, 53.892}, {8.782, 53.842}, {8.861, 53.831}, {9.016, 53.841}, {9.115, 53.865},
+			{9.275, 53.865}, {9.403, 53.742}, {9.485, 53.69}, {9.498, 53.66}, {9.567, 53.6}, {9.697, 53.557},
+			{9.832, 53.544}, {9.782, 53.57}, {9.692, 53.576}, {9.584, 53.612}, {9.551, 53.635},
+			{9.532, 53.711}, {9.436, 53.749}, {9.395, 53.831}, {9.258, 53.886}, {9.03, 53.907},
+			{8.985, 53.897}, {8.916, 53.937}, {8.833, 54.036}, {8.891, 54.048}, {8.997, 54.03},
+			{9.018, 54.098}, {8.974, 54.128}, {8.977, 54.146}, {8.953, 54.15}, {8.861, 54.125},
+			{8.813, 54.18}, {8.847, 54.234}, {8.84, 54.256}, {8.922, 54.274}, {8.963, 54.318},
+			{8.881, 54.29}, {8.888, 54.304}, {8.739, 54.294}, {8.678, 54.269}, {8.623, 54.29}, {8.6, 54.338},
+			{8.696, 54.359}, {8.651, 54.378}, {8.635, 54.365}, {8.629, 54.388}, {8.648, 54.406},
+			{8.886, 54.418}, {9.005, 54.466}, {9.012, 54.506}, {8.879, 54.607}, {8.826, 54.598},
+			{8.819, 54.606}, {8.853, 54.619}, {8.805, 54.688}, {8.689, 54.735}, {8.694, 54.76},
+			{8.648, 54.818}, {8.647, 54.867}, {8.661, 54.896}, {8.696, 54.89}, {8.733, 54.889},
+			{8.801, 54.904}, {8.824, 54.906}, {8.904, 54.898}, {8.983, 54.879}, {9.195, 54.85},
+			{9.212, 54.842}, {9.219, 54.818}, {9.226, 54.806}, {9.244, 54.802}, {9.332, 54.803},
+			{9.367, 54.817}, {9.385, 54.819}, {9.405, 54.808}, {9.422, 54.807}, {9.438, 54.81},
+			{9.451, 54.81}, {9.58, 54.866}, {9.583, 54.83}, {9.735, 54.807}, {9.802, 54.783},
+			{9.824, 54.757}, {9.895, 54.769}, {9.891, 54.79}, {9.948, 54.78}, {10.018, 54.701},
+			{9.97, 54.701}, {9.929, 54.674}, {10.039, 54.667}, {10.027, 54.56}, {9.966, 54.506},
+			{9.84, 54.475}, {9.883, 54.462}, {10.143, 54.492}, {10.204, 54.461}, {10.184, 54.449},
+			{10.191, 54.397}, {10.142, 54.372}, {10.156, 54.343}, {10.142, 54.324}, {10.18, 54.341},
+			{10.224, 54.414}, {10.318, 54.443}, {10.601, 54.365}, {10.69, 54.316}, {10.731, 54.31},
+			{10.777, 54.315}, {10.935, 54.38}, {11.019, 54.386}, {10.976, 54.378}, {11.034, 54.368},
+			{11.104, 54.393}, {11.136, 54.386}, {11.088, 54.352}, {11.067, 54.359}, {11.094, 54.284},
+			{11.09, 54.208}, {11.067, 54.184}, {10.893, 54.103}, {10.805, 54.095}, {10.753, 54.05},
+			{10.8, 54.001}, {10.875, 53.995}, {10.887, 53.964}, {10.923, 53.961}, {11.053, 54.008},
+			{11.175, 54.018}, {11.204, 53.989}, {11.245, 53.978}, {11.243, 53.945}, {11.294, 53.941},
+			{11.334, 53.961}, {11.458, 53.906}, {11.472, 53.969}, {11.498, 53.982}, {11.492, 54.002},
+			{11.526, 54.023}, {11.519, 54.036}, {11.573, 54.039}, {11.625, 54.084}, {11.625, 54.11},
+			{11.69, 54.155}, {11.812, 54.15}, {12.088, 54.194}, {12.092, 54.114}, {12.115, 54.098},
+			{12.095, 54.146}, {12.143, 54.18}, {12.109, 54.183}, {12.187, 54.243}, {12.342, 54.304},
+			{12.502, 54.474}, {12.534, 54.488}, {12.548, 54.462}, {12.591, 54.452}, {12.88, 54.449},
+			{12.921, 54.433}, {12.871, 54.417}, {12.695, 54.434}, {12.624, 54.423}, {12.582, 54.406},
+			{12.595, 54.386}, {12.554, 54.391}, {12.527, 54.372}, {12.491, 54.39}, {12.438, 54.388},
+			{12.418, 54.361}, {12.424, 54.345}, {12.397, 54.352}, {12.392, 54.321}, {12.363, 54.31},
+			{12.38, 54.277}, {12.369, 54.269}, {12.412, 54.251}, {12.458, 54.256}, {12.41, 54.269},
+			{12.472, 54.31}, {12.479, 54.332}, {12.561, 54.359}, {12.541, 54.372}, {12.593, 54.369},
+			{12.677, 54.392}, {12.681, 54.411}, {12.718, 54.414}, {12.684, 54.372}, {12.705, 54.4},
+			{12.735, 54.378}, {12.787, 54.392}, {12.818, 54.358}, {12.855, 54.358}, {12.929, 54.414},
+			{13.034, 54.434}, {13.021, 54.426}, {13.029, 54.402}, {13.096, 54.372}, {13.077, 54.353},
+			{13.115, 54.28}, {13.171, 54.277}, {13.143, 54.263}, {13.289, 54.234}, {13.349, 54.18},
+			{13.322, 54.167}, {13.403, 54.174}, {13.383, 54.153}, {13.418, 54.153}, {13.484, 54.091},
+			{13.507, 54.098}, {13.479, 54.125}, {13.603, 54.139}, {13.712, 54.174}, {13.698, 54.153},
+			{13.808, 54.105}, {13.773, 54.046}, {13.746, 54.036}, {13.839, 53.986}, {13.849, 53.956},
+			{13.906, 53.943}, {13.89, 53.913}, {13.819, 53.878}, {13.817, 53.853}, {14.037, 53.755},
+			{14.187, 53.74}, {14.25, 53.76}, {14.267, 53.735}, {14.213, 53.708}, {14.256, 53.699},
+			{14.264, 53.7}, {14.265, 53.672}, {14.261, 53.656}, {14.276, 53.637}, {14.297, 53.598},
+			{14.297, 53.529}, {14.304, 53.509}, {14.328, 53.486}, {14.339, 53.465}, {14.36, 53.444},
+			{14.37, 53.392}, {14.398, 53.315}, {14.403, 53.279}, {14.442, 53.252}, {14.416, 53.221},
+			{14.388, 53.202}, {14.381, 53.19}, {14.378, 53.177}, {14.39, 53.132}, {14.357, 53.067},
+			{14.343, 53.049}, {14.313, 53.029}, {14.254, 53.001}, {14.193, 52.982}, {14.144, 52.96},
+			{14.165, 52.896}, {14.161, 52.877}, {14.15, 52.865}, {14.124, 52.851}, {14.135, 52.837},
+			{14.153, 52.828}, {14.196, 52.823}, {14.216, 52.818}, {14.249, 52.795}, {14.276, 52.786},
+			{14.382, 52.738}, {14.398, 52.727}, {14.432, 52.686}, {14.461, 52.675}, {14.49, 52.651},
+			{14.55, 52.636}, {14.614, 52.593}, {14.645, 52.577}, {14.617, 52.541}, {14.609, 52.518},
+			{14.628, 52.507}, {14.632, 52.497}, {14.616, 52.473}, {14.592, 52.45}, {14.54, 52.422},
+			{14.545, 52.382}, {14.569, 52.339}, {14.59, 52.309}, {14.584, 52.291}, {14.607, 52.276},
+			{14.64, 52.265}, {14.697, 52.253}, {14.712, 52.236}, {14.712, 52.215}, {14.693, 52.2},
+			{14.693, 52.193}, {14.707, 52.179}, {14.703, 52.165}, {14.692, 52.147}, {14.686, 52.121},
+			{14.696, 52.107}, {14.761, 52.077}, {14.735, 52.054}, {14.726, 52.026}, {14.699, 51.993},
+			{14.696, 51.931}, {14.687, 51.912}, {14.672, 51.894}, {14.653, 51.879}, {14.596, 51.842},
+			{14.583, 51.825}, {14.586, 51.804}, {14.602, 51.788}, {14.62, 51.781}, {14.634, 51.769},
+			{14.638, 51.743}, {14.648, 51.717}, {14.671, 51.701}, {14.72, 51.676}, {14.732, 51.658},
+			{14.742, 51.633}, {14.744, 51.607}, {14.732, 51.587}, {14.713, 51.567}, {14.705, 51.548},
+			{14.71, 51.53}, {14.732, 51.516}, {14.797, 51.502}, {14.841, 51.484}, {14.911, 51.469},
+			{14.945, 51.449}, {14.955, 51.435}, {14.947, 51.423}, {14.957, 51.353}, {14.964, 51.328},
+			{14.982, 51.308}, {15.005, 51.291}, {15.019, 51.272}, {15.014, 51.243}, {15.022, 51.237},
+			{15.005, 51.216}, {14.991, 51.189}, {14.983, 51.161}, {14.98, 51.123}, {14.96, 51.105},
+			{14.955, 51.064}, {14.86, 50.917}, {14.825, 50.892}, {14.81, 50.877}, {14.81, 50.858},
+			{14.795, 50.832}, {14.775, 50.813}, {14.738, 50.811}, {14.7, 50.816}, {14.648, 50.839},
+			{14.613, 50.846}, {14.608, 50.853}, {14.612, 50.871}, {14.635, 50.909}, {14.629, 50.921},
+			{14.617, 50.921}, {14.562, 50.906}, {14.55, 50.912}, {14.556, 50.924}, {14.576, 50.951},
+			{14.577, 50.966}, {14.574, 50.975}, {14.555, 50.993}, {14.542, 50.999}, {14.515, 51.003},
+			{14.503, 51.009}, {14.494, 51.017}, {14.482, 51.037}, {14.473, 51.023}, {14.462, 51.02},
+			{14.426, 51.021}, {14.398, 51.013}, {14.376, 51.017}, {14.357, 51.032}, {14.319, 51.04},
+			{14.288, 51.037}, {14.264, 51.021}, {14.249, 50.993}, {14.238, 50.982}, {14.25, 50.978},
+			{14.284, 50.975}, {14.304, 50.97}, {14.294, 50.964}, {14.293, 50.953}, {14.318, 50.938},
+			{14.355, 50.931}, {14.382, 50.921}, {14.376, 50.896}, {14.347, 50.88}, {14.268, 50.884},
+			{14.232, 50.879}, {14.191, 50.848}, {14.035, 50.803}, {13.979, 50.805}, {13.96, 50.802},
+			{13.923, 50.78}, {13.893, 50.78}, {13.883, 50.773}, {13.879, 50.764}, {13.882, 50.743},
+			{13.875, 50.737}, {13.863, 50.735}, {13.835, 50.724}, {13.817, 50.721}, {13.771, 50.725},
+			{13.749, 50.723}, {13.692, 50.712}, {13.602, 50.712}, {13.557, 50.707}, {13.516, 50.691},
+			{13.527, 50.676}, {13.523, 50.662}, {13.51, 50.651}, {13.493, 50.643}, {13.499, 50.635},
+			{13.498, 50.629}, {13.448, 50.597}, {13.429, 50.594}, {13.407, 50.601}, {13.381, 50.625},
+			{13.369, 50.628}, {13.359, 50.619}, {13.322, 50.602}, {13.306, 50.576}, {13.285, 50.569},
+			{13.252, 50.581}, {13.232, 50.582}, {13.199, 50.525}, {13.185, 50.509}, {13.16, 50.497},
+			{13.107, 50.499}, {13.06, 50.491}, {13.026, 50.498}, {13.01, 50.493}, {13.003, 50.452},
+			{12.997, 50.434}, {12.953, 50.404}, {12.918, 50.405}, {12.817, 50.443}, {12.788, 50.434},
+			{12.754, 50.435}, {12.738, 50.432}, {12.692, 50.395}, {12.625, 50.4}, {12.551, 50.396},
+			{12.51, 50.389}, {12.475, 50.369}, {12.467, 50.355}, {12.469, 50.346}, {12.467, 50.342},
+			{12.45, 50.339}, {12.398, 50.315}, {12.367, 50.279}, {12.336, 50.259}, {12.334, 50.243},
+			{12.314, 50.226}, {12.309, 50.217}, {12.306, 50.199}, {12.314, 50.176}, {12.314, 50.167},
+			{12.3, 50.161}, {12.283, 50.162}, {12.273, 50.172}, {12.254, 50.217}, {12.23, 50.235},
+			{12.23, 50.239}, {12.242, 50.248}, {12.24, 50.257}, {12.204, 50.26}, {12.189, 50.262},
+			{12.178, 50.269}, {12.174, 50.277}, {12.174, 50.294}, {12.169, 50.303}, {12.149, 50.312},
+			{12.076, 50.315}, {12.099, 50.3}, {12.107, 50.291}, {12.111, 50.277}, {12.109, 50.264},
+			{12.092, 50.255}, {12.08, 50.243}, {12.09, 50.231}, {12.139, 50.211}, {12.175, 50.183},
+			{12.183, 50.171}, {12.186, 50.156}, {12.179, 50.133}, {12.179, 50.118}, {12.188, 50.103},
+			{12.233, 50.081}, {12.241, 50.071}, {12.247, 50.045}, {12.399, 49.993}, {12.415, 49.983},
+			{12.451, 49.981}, {12.468, 49.97}, {12.471, 49.956}, {12.464, 49.942}, {12.463, 49.931},
+			{12.518, 49.912}, {12.524, 49.905}, {12.521, 49.886}, {12.513, 49.87}, {12.49, 49.843},
+			{12.483, 49.827}, {12.462, 49.831}, {12.456, 49.817}, {12.453, 49.78}, {12.437, 49.769},
+			{12.395, 49.758}, {12.384, 49.743}, {12.399, 49.719}, {12.42, 49.7}, {12.449, 49.685},
+			{12.496, 49.67}, {12.505, 49.659}, {12.505, 49.646}, {12.497, 49.634}, {12.507, 49.63},
+			{12.502, 49.62}, {12.512, 49.612}, {12.536, 49.603}, {12.547, 49.596}, {12.554, 49.585},
+			{12.562, 49.551}, {12.583, 49.522}, {12.594, 49.515}, {12.616, 49.514}, {12.623, 49.51},
+			{12.627, 49.46}, {12.632, 49.444}, {12.644, 49.429}, {12.663, 49.419}, {12.709, 49.405},
+			{12.729, 49.391}, {12.762, 49.343}, {12.778, 49.333}, {12.798, 49.328}, {12.857, 49.322},
+			{12.875, 49.324}, {12.871, 49.337}, {12.885, 49.34}, {12.94, 49.327}, {12.982, 49.3},
+			{13, 49.295}, {13.011, 49.268}, {13.043, 49.243}, {13.056, 49.238}, {13.149, 49.155},
+			{13.179, 49.118}, {13.207, 49.107}, {13.267, 49.106}, {13.287, 49.101}, {13.3, 49.094},
+			{13.33, 49.067}, {13.372, 49.039}, {13.384, 49.021}, {13.384, 48.993}, {13.399, 48.978},
+			{13.459, 48.945}, {13.482, 48.938}, {13.502, 48.966}, {13.516, 48.97}, {13.539, 48.967},
+			{13.59, 48.945}, {13.609, 48.946}, {13.611, 48.927}, {13.622, 48.909}, {13.637, 48.893},
+			{13.654, 48.882}, {13.673, 48.876}, {13.711, 48.872}, {13.725, 48.867}, {13.748, 48.843},
+			{13.796, 48.78},
+		},
+	},
+	{
+		{
+			{14.21, 53.938}, {14.193, 53.911}, {14.175, 53.906}, {14.193, 53.894}, {14.201, 53.878},
+			{14.087, 53.87}, {14.048, 53.879}, {13.938, 53.845}, {13.875, 53.844}, {13.822, 53.865},
+			{13.932, 53.899}, {13.939, 53.928}, {13.902, 53.97}, {13.904, 53.995}, {13.973, 53.989},
+			{13.959, 53.941}, {13.987, 53.961}, {14.028, 53.961}, {14.014, 53.947}, {14.048, 53.941},
+			{14.051, 54.005}, {13.946, 54.067}, {13.916, 54.056}, {13.925, 54.036}, {13.891, 54.01},
+			{13.856, 54.002}, {13.876, 54.036}, {13.864, 54.05}, {13.794, 54.018}, {13.774, 54.023},
+			{13.815, 54.101}, {13.753, 54.153}, {13.778, 54.174}, {13.815, 54.174}, {13.882, 54.102},
+			{14.017, 54.06}, {14.209, 53.939},
+		},
+	},
+	{
+		{
+			{6.798, 53.604}, {6.722, 53.591}, {6.722, 53.585}, {6.742, 53.578}, {6.757, 53.563},
+			{6.726, 53.577}, {6.695, 53.57}, {6.677, 53.576}, {6.663, 53.587}, {6.66, 53.599},
+			{6.747, 53.618}, {6.785, 53.62},
+		},
+	},
+	{
+		{
+			{6.874, 53.673}, {6.911, 53.684}, {7.047, 53.694}, {7.085, 53.687},
+		},
+	},
+	{
+		{
+			{7.133, 53.708}, {7.179, 53.724}, {7.233, 53.729}, {7.346, 53.728}, {7.347, 53.723},
+			{7.296, 53.711}, {7.174, 53.701},
+		},
+	},
+	{
+		{
+			{7.367, 53.728}, {7.383, 53.735}, {7.403, 53.737}, {7.423, 53.734}, {7.436, 53.728},
+			{7.432, 53.724}, {7.421, 53.721}, {7.381, 53.729},
+		},
+	},
+	{
+		{
+			{8.12, 53.721}, {8.133, 53.734}, {8.152, 53.739}, {8.175, 53.737}, {8.196, 53.728},
+			{8.172, 53.726}, {8.151, 53.714}, {8.131, 53.718}, {8.12, 53.714},
+		},
+	},
+	{
+		{
+			{7.517, 53.762}, {7.607, 53.763}, {7.627, 53.755}, {7.627, 53.751}, {7.548, 53.75},
+			{7.514, 53.746}, {7.511, 53.728}, {7.482, 53.727}, {7.473, 53.732}, {7.47, 53.745},
+			{7.475, 53.757},
+		},
+	},
+	{
+		{
+			{7.661, 53.762}, {7.686, 53.781}, {7.728, 53.787}, {7.813, 53.783}, {7.813, 53.776},
+			{7.799, 53.776}, {7.748, 53.761}, {7.719, 53.76}, {7.695, 53.77}, {7.677, 53.758},
+		},
+	},
+	{
+		{
+			{7.874, 53.776}, {7.855, 53.785}, {7.859, 53.791}, {7.877, 53.795}, {7.914, 53.795},
+			{7.956, 53.783}, {7.875, 53.788},
+		},
+	},
+	{
+		{
+			{11.376, 53.982}, {11.39, 54.005}, {11.427, 54.023}, {11.468, 54.031}, {11.498, 54.023},
+			{11.468, 53.971}, {11.458, 53.961}, {11.445, 53.961}, {11.445, 53.968}, {11.451, 53.986},
+			{11.447, 53.997}, {11.439, 53.997}, {11.433, 53.989}, {11.438, 53.975}, {11.423, 53.968},
+			{11.407, 53.968},
+		},
+	},
+	{
+		{
+			{11.528, 54.075}, {11.534, 54.085}, {11.602, 54.104}, {11.616, 54.112}, {11.599, 54.098},
+			{11.553, 54.073}, {11.54, 54.057}, {11.534, 54.057},
+		},
+	},
+	{
+		{
+			{8.963, 54.524}, {8.956, 54.519}, {8.957, 54.509}, {8.911, 54.475}, {8.888, 54.468},
+			{8.844, 54.466}, {8.824, 54.471}, {8.813, 54.482}, {8.824, 54.486}, {8.826, 54.491},
+			{8.813, 54.502}, {8.844, 54.52}, {8.88, 54.529}, {8.919, 54.53},
+		},
+	},
+	{
+		{
+			{11.256, 54.479}, {11.314, 54.414}, {11.188, 54.426}, {11.17, 54.42}, {11.179, 54.414},
+			{11.113, 54.415}, {11.101, 54.423}, {11.099, 54.452}, {11.058, 54.455}, {11.032, 54.447},
+			{11.026, 54.459}, {11.008, 54.455}, {11.006, 54.468}, {11.034, 54.509}, {11.073, 54.531},
+			{11.121, 54.536}, {11.207, 54.514},
+		},
+	},
+	{
+		{
+			{13.143, 54.598}, {13.119, 54.586}, {13.112, 54.551}, {13.103, 54.53}, {13.075, 54.509},
+			{13.068, 54.468}, {13.061, 54.468}, {13.064, 54.505}, {13.096, 54.571}, {13.102, 54.596},
+			{13.11, 54.603}, {13.151, 54.605}, {13.157, 54.599}, {13.157, 54.588}, {13.15, 54.591},
+			{13.15, 54.578}, {13.143, 54.578},
+		},
+	},
+	{
+		{
+			{13.698, 54.392}, {13.766, 54.345}, {13.73, 54.317}, {13.723, 54.278}, {13.691, 54.284},
+			{13.712, 54.287}, {13.712, 54.304}, {13.651, 54.297}, {13.712, 54.332}, {13.617, 54.318},
+			{13.691, 54.352}, {13.627, 54.339}, {13.599, 54.352}, {13.5, 54.345}, {13.406, 54.285},
+			{13.36, 54.275}, {13.356, 54.249}, {13.363, 54.263}, {13.418, 54.266}, {13.423, 54.233},
+			{13.407, 54.228}, {13.312, 54.251}, {13.335, 54.284}, {13.315, 54.263}, {13.268, 54.256},
+			{13.21, 54.276}, {13.205, 54.297}, {13.15, 54.29}, {13.149, 54.305}, {13.178, 54.31},
+			{13.115, 54.338}, {13.149, 54.377}, {13.223, 54.373}, {13.267, 54.386}, {13.239, 54.394},
+			{13.239, 54.42}, {13.212, 54.431}, {13.157, 54.427}, {13.182, 54.453}, {13.273, 54.482},
+			{13.233, 54.493}, {13.233, 54.516}, {13.185, 54.508}, {13.143, 54.543}, {13.26, 54.554},
+			{13.298, 54.52}, {13.301, 54.55}, {13.369, 54.585}, {13.342, 54.55}, {13.363, 54.543},
+			{13.349, 54.524}, {13.384, 54.532}, {13.39, 54.543}, {13.363, 54.55}, {13.377, 54.565},
+			{13.431, 54.493}, {13.507, 54.488}, {13.521, 54.524}, {13.5, 54.557}, {13.521, 54.571},
+			{13.441, 54.557}, {13.369, 54.612}, {13.273, 54.561}, {13.253, 54.565}, {13.257, 54.595},
+			{13.293, 54.642}, {13.225, 54.635}, {13.231, 54.653}, {13.391, 54.688}, {13.445, 54.68},
+			{13.383, 54.636}, {13.395, 54.604}, {13.425, 54.585}, {13.596, 54.591}, {13.671, 54.566},
+			{13.677, 54.54}, {13.575, 54.472}, {13.61, 54.414},
+		},
+	},
+	{
+		{
+			{8.332, 54.694}, {8.382, 54.639}, {8.401, 54.633}, {8.388, 54.626}, {8.354, 54.619},
+			{8.33, 54.631}, {8.292, 54.667}, {8.32, 54.699}, {8.338, 54.71}, {8.36, 54.715}, {8.361, 54.71},
+		},
+	},
+	{
+		{
+			{8.498, 54.756}, {8.553, 54.754}, {8.576, 54.746}, {8.594, 54.729}, {8.566, 54.688},
+			{8.535, 54.686}, {8.511, 54.694}, {8.485, 54.688}, {8.395, 54.715}, {8.414, 54.736},
+			{8.437, 54.748},
+		},
+	},
+	{
+		{
+			{8.36, 54.948}, {8.355, 54.913}, {8.388, 54.894}, {8.654, 54.894}, {8.629, 54.882},
+			{8.504, 54.886}, {8.446, 54.866}, {8.377, 54.866}, {8.344, 54.879}, {8.307, 54.865},
+			{8.295, 54.832}, {8.296, 54.759}, {8.284, 54.742}, {8.277, 54.783}, {8.298, 54.913},
+			{8.374, 55.035}, {8.416, 55.065}, {8.463, 55.051}, {8.395, 55.044}, {8.422, 55.042},
+			{8.43, 55.03}, {8.362, 54.987}, {8.352, 54.967},
+		},
+	},
+	{
+		{
+			{8.635, 54.543}, {8.699, 54.559}, {8.701, 54.548}, {8.693, 54.523}, {8.67, 54.502},
+			{8.641, 54.492}, {8.619, 54.493}, {8.606, 54.499}, {8.596, 54.514}, {8.602, 54.53},
+		},
+	},
+	{
+		{
+			{7.904, 54.18}, {7.899, 54.178}, {7.898, 54.182}, {7.894, 54.183}, {7.89, 54.188},
+			{7.88, 54.194}, {7.895, 54.195}, {7.901, 54.191},
+		},
+	},
+	{
+		{
+			{7.912, 54.192}, {7.93, 54.195}, {7.933, 54.19}, {7.918, 54.187},
+		},
+	},
+})
+
+// greatBritain is Great Britain with the Isle of Man and without Northern Ireland.
+var greatBritain = newBoundary(MultiPolygon{
+	{
+		{
+			{-2.665, 51.617}, {-2.713, 51.587}, {-2.859, 51.546}, {-2.943, 51.543}, {-2.975, 51.563},
+			{-3.173, 51.453}, {-3.16, 51.432}, {-3.185, 51.398}, {-3.289, 51.384}, {-3.539, 51.399},
+			{-3.663, 51.476}, {-3.695, 51.472}, {-3.738, 51.491}, {-3.77, 51.563}, {-3.842, 51.622},
+			{-3.977, 51.612}, {-4, 51.591}, {-3.975, 51.563}, {-4.027, 51.554}, {-4.046, 51.568},
+			{-4.07, 51.556}, {-4.122, 51.566}, {-4.201, 51.539}, {-4.29, 51.556}, {-4.297, 51.569},
+			{-4.278, 51.578}, {-4.29, 51.617}, {-4.235, 51.645}, {-4.221, 51.624}, {-4.091, 51.64},
+			{-4.071, 51.676}, {-4.107, 51.664}, {-4.201, 51.686}, {-4.315, 51.677}, {-4.379, 51.727},
+			{-4.31, 51.734}, {-4.363, 51.743}, {-4.365, 51.789}, {-4.399, 51.762}, {-4.446, 51.773},
+			{-4.427, 51.747}, {-4.461, 51.736}, {-4.645, 51.732}, {-4.678, 51.717}, {-4.712, 51.65},
+			{-4.735, 51.659}, {-4.759, 51.645}, {-4.864, 51.644}, {-4.941, 51.597}, {-5.046, 51.627},
+			{-5.037, 51.637}, {-5.051, 51.657}, {-5.12, 51.678}, {-5.037, 51.678}, {-5.043, 51.693},
+			{-4.988, 51.686}, {-4.845, 51.714}, {-4.879, 51.762}, {-4.824, 51.796}, {-4.934, 51.775},
+			{-4.901, 51.762}, {-4.906, 51.747}, {-4.892, 51.739}, {-4.911, 51.718}, {-5.01, 51.706},
+			{-5.149, 51.718}, {-5.167, 51.714}, {-5.157, 51.698}, {-5.183, 51.689}, {-5.181, 51.706},
+			{-5.25, 51.734}, {-5.11, 51.779}, {-5.126, 51.856}, {-5.222, 51.878}, {-5.311, 51.864},
+			{-5.291, 51.892}, {-5.298, 51.911}, {-5.12, 51.959}, {-5.088, 51.976}, {-5.07, 52.026},
+			{-4.962, 52.008}, {-4.914, 52.015}, {-4.921, 52.029}, {-4.841, 52.015}, {-4.838, 52.049},
+			{-4.778, 52.065}, {-4.72, 52.113}, {-4.673, 52.097}, {-4.676, 52.127}, {-4.638, 52.138},
+			{-4.502, 52.138}, {-4.386, 52.203}, {-4.316, 52.216}, {-4.197, 52.279}, {-4.11, 52.365},
+			{-4.057, 52.488}, {-4.057, 52.529}, {-4.016, 52.522}, {-3.947, 52.549}, {-4.053, 52.542},
+			{-4.125, 52.604}, {-4.106, 52.654}, {-4.057, 52.701}, {-3.988, 52.734}, {-4.049, 52.722},
+			{-4.149, 52.812}, {-4.117, 52.831}, {-4.13, 52.889}, {-4.064, 52.919}, {-4.078, 52.927},
+			{-4.1, 52.914}, {-4.119, 52.927}, {-4.144, 52.915}, {-4.229, 52.919}, {-4.337, 52.892},
+			{-4.407, 52.893}, {-4.482, 52.851}, {-4.501, 52.827}, {-4.489, 52.803}, {-4.516, 52.789},
+			{-4.595, 52.817}, {-4.763, 52.789}, {-4.735, 52.83}, {-4.596, 52.928}, {-4.524, 52.944},
+			{-4.358, 53.028}, {-4.341, 53.113}, {-4.321, 53.102}, {-4.178, 53.218}, {-4.122, 53.233},
+			{-4.067, 53.229}, {-3.831, 53.289}, {-3.878, 53.338}, {-3.769, 53.319}, {-3.716, 53.287},
+			{-3.602, 53.289}, {-3.33, 53.352}, {-3.088, 53.235}, {-3.085, 53.275}, {-3.186, 53.393},
+			{-3.058, 53.435}, {-3.012, 53.417}, {-2.953, 53.324}, {-2.881, 53.288}, {-2.776, 53.3},
+			{-2.748, 53.31}, {-2.743, 53.339}, {-2.7, 53.352}, {-2.764, 53.345}, {-2.8, 53.321},
+			{-2.925, 53.35}, {-2.981, 53.393}, {-3.102, 53.558}, {-3.022, 53.653}, {-2.899, 53.735},
+			{-2.985, 53.736}, {-3.049, 53.77}, {-3.045, 53.878}, {-3.057, 53.906}, {-3.018, 53.932},
+			{-2.926, 53.953}, {-2.889, 53.946}, {-2.858, 53.968}, {-2.869, 53.979}, {-2.83, 54.016},
+			{-2.895, 54.002}, {-2.918, 54.034}, {-2.827, 54.089}, {-2.796, 54.125}, {-2.854, 54.194},
+			{-2.81, 54.212}, {-2.796, 54.249}, {-2.939, 54.155}, {-2.998, 54.16}, {-3.015, 54.212},
+			{-3.049, 54.222}, {-3.061, 54.162}, {-3.145, 54.1}, {-3.145, 54.064}, {-3.19, 54.098},
+			{-3.241, 54.112}, {-3.233, 54.159}, {-3.248, 54.174}, {-3.215, 54.18}, {-3.207, 54.263},
+			{-3.231, 54.247}, {-3.232, 54.203}, {-3.307, 54.192}, {-3.407, 54.278}, {-3.413, 54.343},
+			{-3.634, 54.513}, {-3.59, 54.565}, {-3.564, 54.643}, {-3.509, 54.722}, {-3.44, 54.761},
+			{-3.44, 54.797}, {-3.388, 54.883}, {-3.345, 54.903}, {-3.306, 54.886}, {-3.255, 54.9},
+			{-3.317, 54.92}, {-3.277, 54.943}, {-3.214, 54.954}, {-3.123, 54.933}, {-3.022, 54.954},
+			{-3.091, 54.954}, {-3.022, 54.976}, {-3.135, 54.968}, {-3.501, 54.992}, {-3.526, 54.977},
+			{-3.571, 54.995}, {-3.584, 54.968}, {-3.569, 54.916}, {-3.582, 54.884}, {-3.718, 54.881},
+			{-3.739, 54.86}, {-3.81, 54.866}, {-3.812, 54.855}, {-3.831, 54.866}, {-3.81, 54.873},
+			{-3.817, 54.886}, {-3.858, 54.845}, {-3.824, 54.825}, {-3.964, 54.772}, {-4.012, 54.768},
+			{-4.056, 54.783}, {-4.048, 54.813}, {-4.064, 54.832}, {-4.09, 54.81}, {-4.084, 54.777},
+			{-4.123, 54.773}, {-4.174, 54.792}, {-4.209, 54.826}, {-4.201, 54.866}, {-4.271, 54.839},
+			{-4.357, 54.866}, {-4.403, 54.907}, {-4.427, 54.869}, {-4.417, 54.846}, {-4.344, 54.795},
+			{-4.358, 54.771}, {-4.344, 54.756}, {-4.357, 54.742}, {-4.348, 54.702}, {-4.382, 54.68},
+			{-4.497, 54.701}, {-4.597, 54.775}, {-4.781, 54.833}, {-4.8, 54.861}, {-4.859, 54.866},
+			{-4.926, 54.837}, {-4.955, 54.805}, {-4.896, 54.705}, {-4.867, 54.69}, {-4.872, 54.649},
+			{-4.859, 54.633}, {-4.954, 54.66}, {-4.955, 54.729}, {-4.988, 54.735}, {-5.003, 54.771},
+			{-5.157, 54.879}, {-5.181, 54.954}, {-5.171, 55.009}, {-5.125, 55.017}, {-5.115, 55.032},
+			{-5.069, 54.986}, {-5.061, 54.931}, {-5.031, 54.911}, {-4.996, 54.92}, {-4.988, 54.935},
+			{-5.05, 55.027}, {-5.008, 55.141}, {-4.867, 55.222}, {-4.833, 55.331}, {-4.773, 55.369},
+			{-4.749, 55.414}, {-4.651, 55.448}, {-4.618, 55.496}, {-4.651, 55.537}, {-4.687, 55.544},
+			{-4.666, 55.562}, {-4.693, 55.605}, {-4.818, 55.637}, {-4.811, 55.654}, {-4.921, 55.709},
+			{-4.861, 55.757}, {-4.883, 55.821}, {-4.886, 55.92}, {-4.849, 55.948}, {-4.799, 55.958},
+			{-4.673, 55.934}, {-4.482, 55.928}, {-4.673, 55.962}, {-4.783, 56.018}, {-4.824, 56.079},
+			{-4.844, 56.073}, {-4.838, 56.051}, {-4.779, 55.992}, {-4.852, 55.988}, {-4.865, 56.067},
+			{-4.755, 56.188}, {-4.756, 56.207}, {-4.852, 56.113}, {-4.881, 56.12}, {-4.894, 56.174},
+			{-4.921, 56.168}, {-4.886, 56.08}, {-4.904, 56.054}, {-4.893, 55.99}, {-4.955, 55.997},
+			{-4.914, 55.962}, {-4.983, 55.871}, {-5.042, 55.885}, {-5.071, 55.959}, {-5.124, 56.01},
+			{-5.14, 56.003}, {-5.086, 55.932}, {-5.087, 55.901}, {-5.172, 55.936}, {-5.181, 55.969},
+			{-5.242, 55.893}, {-5.201, 55.831}, {-5.297, 55.853}, {-5.338, 55.904}, {-5.324, 55.959},
+			{-5.338, 55.997}, {-5.204, 56.119}, {-5.105, 56.152}, {-5.043, 56.216}, {-4.94, 56.251},
+			{-4.921, 56.278}, {-4.975, 56.248}, {-5.065, 56.229}, {-5.109, 56.175}, {-5.228, 56.129},
+			{-5.311, 56.058}, {-5.346, 56.051}, {-5.369, 56.009}, {-5.434, 56.01}, {-5.444, 56.028},
+			{-5.455, 55.956}, {-5.431, 55.948}, {-5.4, 55.893}, {-5.407, 55.88}, {-5.393, 55.874},
+			{-5.407, 55.866}, {-5.352, 55.832}, {-5.318, 55.783}, {-5.433, 55.721}, {-5.469, 55.654},
+			{-5.49, 55.645}, {-5.484, 55.61}, {-5.455, 55.592}, {-5.489, 55.569}, {-5.482, 55.55},
+			{-5.51, 55.517}, {-5.511, 55.484}, {-5.585, 55.427}, {-5.544, 55.407}, {-5.515, 55.374},
+			{-5.525, 55.359}, {-5.616, 55.307}, {-5.755, 55.297}, {-5.783, 55.314}, {-5.791, 55.399},
+			{-5.716, 55.448}, {-5.722, 55.514}, {-5.708, 55.543}, {-5.722, 55.565}, {-5.673, 55.641},
+			{-5.681, 55.674}, {-5.627, 55.701}, {-5.581, 55.758}, {-5.505, 55.789}, {-5.455, 55.846},
+			{-5.599, 55.764}, {-5.66, 55.797}, {-5.674, 55.846}, {-5.571, 55.935}, {-5.672, 55.887},
+			{-5.696, 55.916}, {-5.571, 56.038}, {-5.626, 56.024}, {-5.675, 55.978}, {-5.691, 55.938},
+			{-5.708, 55.943}, {-5.696, 55.982}, {-5.577, 56.097}, {-5.532, 56.086}, {-5.559, 56.131},
+			{-5.51, 56.188}, {-5.606, 56.14}, {-5.551, 56.216}, {-5.559, 56.23}, {-5.493, 56.26},
+			{-5.513, 56.271}, {-5.592, 56.25}, {-5.573, 56.328}, {-5.544, 56.353}, {-5.448, 56.36},
+			{-5.538, 56.36}, {-5.457, 56.441}, {-5.323, 56.455}, {-5.209, 56.447}, {-5.119, 56.508},
+			{-5.065, 56.565}, {-5.201, 56.462}, {-5.35, 56.473}, {-5.378, 56.459}, {-5.411, 56.504},
+			{-5.455, 56.47}, {-5.469, 56.476}, {-5.428, 56.538}, {-5.357, 56.52}, {-5.242, 56.565},
+			{-5.372, 56.53}, {-5.414, 56.545}, {-5.361, 56.606}, {-5.298, 56.646}, {-5.318, 56.661},
+			{-5.219, 56.689}, {-5.153, 56.681}, {-4.996, 56.716}, {-5.161, 56.696}, {-5.238, 56.717},
+			{-5.12, 56.818}, {-5.233, 56.761}, {-5.268, 56.713}, {-5.31, 56.706}, {-5.388, 56.655},
+			{-5.434, 56.643}, {-5.6, 56.529}, {-5.674, 56.497}, {-5.77, 56.538}, {-5.749, 56.572},
+			{-5.794, 56.543}, {-5.854, 56.55}, {-6.003, 56.619}, {-6.008, 56.642}, {-5.934, 56.655},
+			{-5.839, 56.627}, {-5.832, 56.634}, {-5.866, 56.661}, {-5.76, 56.701}, {-5.647, 56.681},
+			{-5.544, 56.695}, {-5.637, 56.689}, {-5.75, 56.715}, {-5.897, 56.675}, {-6.099, 56.697},
+			{-6.162, 56.678}, {-6.223, 56.695}, {-6.234, 56.729}, {-6.181, 56.755}, {-6.027, 56.764},
+			{-5.965, 56.785}, {-5.852, 56.747}, {-5.886, 56.785}, {-5.757, 56.785}, {-5.832, 56.805},
+			{-5.851, 56.829}, {-5.734, 56.845}, {-5.66, 56.873}, {-5.744, 56.854}, {-5.784, 56.86},
+			{-5.736, 56.894}, {-5.921, 56.894}, {-5.852, 56.9}, {-5.88, 56.922}, {-5.832, 56.997},
+			{-5.755, 57.027}, {-5.659, 56.979}, {-5.571, 56.984}, {-5.523, 57.004}, {-5.629, 56.992},
+			{-5.686, 57.01}, {-5.68, 57.036}, {-5.702, 57.045}, {-5.742, 57.038}, {-5.78, 57.052},
+			{-5.791, 57.059}, {-5.776, 57.076}, {-5.716, 57.12}, {-5.646, 57.129}, {-5.558, 57.1},
+			{-5.4, 57.106}, {-5.42, 57.121}, {-5.541, 57.106}, {-5.585, 57.134}, {-5.681, 57.158},
+			{-5.626, 57.216}, {-5.644, 57.233}, {-5.578, 57.267}, {-5.544, 57.271}, {-5.446, 57.223},
+			{-5.407, 57.23}, {-5.502, 57.278}, {-5.441, 57.319}, {-5.465, 57.325}, {-5.578, 57.278},
+			{-5.721, 57.284}, {-5.729, 57.298}, {-5.702, 57.332}, {-5.654, 57.354}, {-5.613, 57.342},
+			{-5.501, 57.371}, {-5.457, 57.394}, {-5.448, 57.422}, {-5.563, 57.363}, {-5.64, 57.374},
+			{-5.607, 57.389}, {-5.62, 57.417}, {-5.707, 57.36}, {-5.808, 57.355}, {-5.825, 57.394},
+			{-5.811, 57.401}, {-5.825, 57.429}, {-5.811, 57.442}, {-5.859, 57.453}, {-5.873, 57.494},
+			{-5.847, 57.559}, {-5.832, 57.559}, {-5.839, 57.579}, {-5.804, 57.581}, {-5.64, 57.511},
+			{-5.654, 57.532}, {-5.513, 57.541}, {-5.588, 57.561}, {-5.66, 57.545}, {-5.694, 57.566},
+			{-5.681, 57.579}, {-5.714, 57.583}, {-5.763, 57.634}, {-5.806, 57.643}, {-5.805, 57.667},
+			{-5.785, 57.68}, {-5.791, 57.696}, {-5.734, 57.708}, {-5.692, 57.691}, {-5.674, 57.71},
+			{-5.721, 57.733}, {-5.804, 57.744}, {-5.811, 57.833}, {-5.777, 57.868}, {-5.699, 57.869},
+			{-5.674, 57.861}, {-5.66, 57.792}, {-5.606, 57.77}, {-5.626, 57.792}, {-5.585, 57.785},
+			{-5.585, 57.831}, {-5.65, 57.878}, {-5.64, 57.888}, {-5.654, 57.895}, {-5.613, 57.929},
+			{-5.551, 57.915}, {-5.556, 57.888}, {-5.487, 57.856}, {-5.455, 57.854}, {-5.437, 57.898},
+			{-5.415, 57.908}, {-5.222, 57.847}, {-5.393, 57.918}, {-5.393, 57.936}, {-5.372, 57.931},
+			{-5.366, 57.943}, {-5.297, 57.908}, {-5.256, 57.915}, {-5.199, 57.898}, {-5.071, 57.833},
+			{-5.097, 57.871}, {-5.222, 57.921}, {-5.194, 57.936}, {-5.204, 57.96}, {-5.293, 57.981},
+			{-5.359, 58.011}, {-5.367, 58.029}, {-5.428, 58.031}, {-5.414, 58.052}, {-5.447, 58.084},
+			{-5.428, 58.098}, {-5.39, 58.092}, {-5.366, 58.066}, {-5.301, 58.07}, {-5.277, 58.086},
+			{-5.291, 58.121}, {-5.277, 58.127}, {-5.297, 58.135}, {-5.242, 58.148}, {-5.331, 58.182},
+			{-5.324, 58.203}, {-5.393, 58.245}, {-5.392, 58.261}, {-5.294, 58.241}, {-5.216, 58.261},
+			{-5.173, 58.251}, {-5.129, 58.271}, {-5.068, 58.251}, {-5.002, 58.251}, {-4.934, 58.223},
+			{-4.982, 58.251}, {-4.927, 58.265}, {-5.05, 58.26}, {-5.136, 58.306}, {-5.173, 58.354},
+			{-5.153, 58.354}, {-5.163, 58.374}, {-5.143, 58.415}, {-5.016, 58.388}, {-5.057, 58.422},
+			{-5.098, 58.415}, {-5.085, 58.429}, {-5.106, 58.437}, {-5.05, 58.454}, {-4.996, 58.437},
+			{-5.106, 58.487}, {-5.106, 58.506}, {-5.028, 58.544}, {-5.005, 58.618}, {-4.988, 58.628},
+			{-4.836, 58.605}, {-4.804, 58.559}, {-4.818, 58.525}, {-4.792, 58.542}, {-4.777, 58.607},
+			{-4.66, 58.556}, {-4.667, 58.532}, {-4.714, 58.506}, {-4.761, 58.448}, {-4.612, 58.525},
+			{-4.585, 58.574}, {-4.475, 58.566}, {-4.427, 58.546}, {-4.427, 58.525}, {-4.495, 58.45},
+			{-4.344, 58.539}, {-4.307, 58.546}, {-4.235, 58.525}, {-4.249, 58.532}, {-4.221, 58.552},
+			{-4.081, 58.562}, {-4.029, 58.595}, {-4.004, 58.571}, {-3.896, 58.566}, {-3.81, 58.573},
+			{-3.598, 58.627}, {-3.543, 58.621}, {-3.556, 58.614}, {-3.532, 58.602}, {-3.447, 58.614},
+			{-3.364, 58.601}, {-3.351, 58.621}, {-3.413, 58.656}, {-3.375, 58.677}, {-3.341, 58.648},
+			{-3.204, 58.661}, {-3.152, 58.641}, {-3.019, 58.64}, {-3.049, 58.587}, {-3.129, 58.513},
+			{-3.119, 58.484}, {-3.064, 58.483}, {-3.053, 58.466}, {-3.13, 58.369}, {-3.221, 58.306},
+			{-3.385, 58.265}, {-3.515, 58.168}, {-3.809, 58.052}, {-3.852, 58.004}, {-3.991, 57.96},
+			{-4.002, 57.936}, {-4.084, 57.964}, {-4.078, 57.943}, {-4.016, 57.932}, {-3.988, 57.908},
+			{-4.009, 57.868}, {-4.07, 57.874}, {-4.105, 57.854}, {-4.218, 57.874}, {-4.299, 57.863},
+			{-4.392, 57.908}, {-4.308, 57.851}, {-4.208, 57.859}, {-4.15, 57.83}, {-4.133, 57.83},
+			{-4.139, 57.847}, {-4.04, 57.818}, {-3.984, 57.842}, {-3.951, 57.838}, {-3.934, 57.826},
+			{-3.947, 57.813}, {-3.915, 57.808}, {-3.798, 57.867}, {-3.779, 57.856}, {-3.79, 57.831},
+			{-3.988, 57.696}, {-4.024, 57.698}, {-4.016, 57.723}, {-4.043, 57.737}, {-4.078, 57.73},
+			{-4.169, 57.689}, {-4.295, 57.68}, {-4.309, 57.655}, {-4.412, 57.603}, {-4.428, 57.578},
+			{-4.38, 57.589}, {-4.329, 57.626}, {-4.202, 57.675}, {-4.097, 57.658}, {-4.009, 57.689},
+			{-4.025, 57.655}, {-4.119, 57.593}, {-4.105, 57.579}, {-4.171, 57.571}, {-4.185, 57.548},
+			{-4.263, 57.552}, {-4.208, 57.538}, {-4.249, 57.497}, {-4.195, 57.492}, {-4.035, 57.557},
+			{-4.084, 57.586}, {-3.837, 57.593}, {-3.685, 57.654}, {-3.625, 57.662}, {-3.646, 57.641},
+			{-3.591, 57.641}, {-3.612, 57.668}, {-3.536, 57.662}, {-3.505, 57.679}, {-3.492, 57.713},
+			{-3.409, 57.723}, {-3.285, 57.72}, {-3.21, 57.694}, {-3.035, 57.669}, {-2.916, 57.703},
+			{-2.758, 57.703}, {-2.731, 57.689}, {-2.659, 57.697}, {-2.542, 57.682}, {-2.526, 57.669},
+			{-2.498, 57.682}, {-2.433, 57.668}, {-2.361, 57.682}, {-2.329, 57.674}, {-2.296, 57.696},
+			{-2.21, 57.676}, {-2.108, 57.705}, {-1.998, 57.703}, {-1.974, 57.676}, {-1.932, 57.682},
+			{-1.829, 57.613}, {-1.8, 57.526}, {-1.761, 57.497}, {-1.781, 57.48}, {-1.759, 57.474},
+			{-1.83, 57.426}, {-1.85, 57.394}, {-1.98, 57.319}, {-2.069, 57.175}, {-2.071, 57.136},
+			{-2.049, 57.119}, {-2.187, 56.985}, {-2.2, 56.949}, {-2.186, 56.915}, {-2.22, 56.873},
+			{-2.32, 56.802}, {-2.426, 56.751}, {-2.452, 56.691}, {-2.487, 56.654}, {-2.481, 56.627},
+			{-2.515, 56.591}, {-2.7, 56.504}, {-2.728, 56.47}, {-3.061, 56.452}, {-3.238, 56.368},
+			{-3.323, 56.366}, {-3.231, 56.356}, {-2.885, 56.458}, {-2.803, 56.428}, {-2.808, 56.391},
+			{-2.838, 56.366}, {-2.813, 56.366}, {-2.803, 56.345}, {-2.655, 56.322}, {-2.577, 56.278},
+			{-2.648, 56.229}, {-2.784, 56.192}, {-2.964, 56.206}, {-3.128, 56.123}, {-3.164, 56.064},
+			{-3.343, 56.027}, {-3.671, 56.051}, {-3.817, 56.112}, {-3.837, 56.106}, {-3.761, 56.072},
+			{-3.721, 56.031}, {-3.687, 56.031}, {-3.656, 56.01}, {-3.577, 56.017}, {-3.405, 55.99},
+			{-3.331, 55.994}, {-3.303, 55.978}, {-3.122, 55.969}, {-3.078, 55.947}, {-2.94, 55.969},
+			{-2.858, 56.01}, {-2.871, 56.031}, {-2.823, 56.06}, {-2.635, 56.058}, {-2.569, 56.024},
+			{-2.584, 56.003}, {-2.529, 55.997}, {-2.508, 56.01}, {-2.308, 55.935}, {-2.138, 55.915},
+			{-2.129, 55.89}, {-2.08, 55.869}, {-2.01, 55.791}, {-1.816, 55.633}, {-1.781, 55.646},
+			{-1.748, 55.619}, {-1.768, 55.613}, {-1.699, 55.613}, {-1.631, 55.585}, {-1.635, 55.564},
+			{-1.617, 55.55}, {-1.631, 55.537}, {-1.603, 55.524}, {-1.611, 55.508}, {-1.585, 55.484},
+			{-1.589, 55.377}, {-1.556, 55.311}, {-1.568, 55.28}, {-1.508, 55.195}, {-1.518, 55.157},
+			{-1.413, 55}, {-1.363, 54.965}, {-1.356, 54.904}, {-1.275, 54.748}, {-1.172, 54.701},
+			{-1.192, 54.689}, {-1.165, 54.653}, {-1.204, 54.626}, {-1.153, 54.613}, {-1.138, 54.647},
+			{-1.104, 54.625}, {-0.788, 54.561}, {-0.563, 54.477}, {-0.523, 54.447}, {-0.52, 54.42},
+			{-0.448, 54.373}, {-0.415, 54.297}, {-0.391, 54.284}, {-0.398, 54.269}, {-0.37, 54.248},
+			{-0.277, 54.218}, {-0.26, 54.177}, {-0.234, 54.16}, {-0.076, 54.112}, {-0.164, 54.083},
+			{-0.219, 54.023}, {-0.151, 53.899}, {0.133, 53.643}, {0.149, 53.61}, {0.131, 53.573},
+			{0.11, 53.563}, {0.136, 53.611}, {0.12, 53.618}, {0.035, 53.646}, {-0.045, 53.626},
+			{-0.092, 53.631}, {-0.26, 53.735}, {-0.544, 53.709}, {-0.637, 53.732}, {-0.726, 53.701},
+			{-0.692, 53.687}, {-0.677, 53.703}, {-0.62, 53.712}, {-0.53, 53.678}, {-0.486, 53.694},
+			{-0.279, 53.707}, {-0.189, 53.62}, {-0.096, 53.577}, {-0.047, 53.57}, {0.096, 53.488},
+			{0.152, 53.476}, {0.176, 53.438}, {0.219, 53.42}, {0.212, 53.413}, {0.256, 53.375},
+			{0.342, 53.226}, {0.357, 53.146}, {0.33, 53.086}, {0.161, 53.009}, {0.082, 52.939},
+			{0.022, 52.913}, {0.01, 52.886}, {0.106, 52.889}, {0.171, 52.862}, {0.247, 52.796},
+			{0.326, 52.803}, {0.385, 52.768}, {0.379, 52.791}, {0.439, 52.841}, {0.486, 52.933},
+			{0.57, 52.969}, {0.685, 52.986}, {0.968, 52.947}, {1.014, 52.96}, {0.966, 52.974},
+			{1.275, 52.929}, {1.396, 52.891}, {1.644, 52.776}, {1.697, 52.731}, {1.747, 52.625},
+			{1.748, 52.533}, {1.771, 52.486}, {1.684, 52.325}, {1.631, 52.27}, {1.631, 52.2},
+			{1.582, 52.081}, {1.487, 52.049}, {1.472, 52.056}, {1.425, 52.002}, {1.332, 51.94},
+			{1.264, 51.994}, {1.158, 52.029}, {1.214, 51.991}, {1.271, 51.982}, {1.271, 51.956},
+			{1.165, 51.967}, {1.069, 51.953}, {1.282, 51.947}, {1.278, 51.928}, {1.2, 51.878},
+			{1.264, 51.864}, {1.287, 51.882}, {1.276, 51.845}, {1.168, 51.79}, {1.065, 51.775},
+			{1.037, 51.782}, {0.98, 51.844}, {0.962, 51.814}, {0.887, 51.782}, {0.874, 51.747},
+			{0.699, 51.72}, {0.761, 51.693}, {0.93, 51.744}, {0.946, 51.734}, {0.938, 51.706},
+			{0.952, 51.678}, {0.935, 51.636}, {0.911, 51.624}, {0.952, 51.617}, {0.924, 51.588},
+			{0.771, 51.528}, {0.651, 51.536}, {0.569, 51.508}, {0.553, 51.518}, {0.456, 51.506},
+			{0.428, 51.467}, {0.385, 51.453}, {0.535, 51.492}, {0.695, 51.477}, {0.723, 51.447},
+			{0.641, 51.444}, {0.573, 51.42}, {0.555, 51.412}, {0.577, 51.394}, {0.713, 51.384}, {0.7, 51.41},
+			{0.726, 51.419}, {0.764, 51.364}, {0.977, 51.349}, {1.101, 51.373}, {1.424, 51.392},
+			{1.448, 51.383}, {1.436, 51.344}, {1.378, 51.326}, {1.413, 51.222}, {1.384, 51.152},
+			{1.357, 51.131}, {1.218, 51.101}, {1.192, 51.083}, {1.067, 51.064}, {0.966, 50.983},
+			{0.98, 50.918}, {0.945, 50.909}, {0.802, 50.939}, {0.762, 50.931}, {0.624, 50.859},
+			{0.365, 50.819}, {0.271, 50.747}, {0.122, 50.761}, {-0.173, 50.829}, {-0.27, 50.831},
+			{-0.398, 50.802}, {-0.569, 50.802}, {-0.733, 50.767}, {-0.758, 50.777}, {-0.773, 50.766},
+			{-0.771, 50.737}, {-0.79, 50.73}, {-0.904, 50.772}, {-0.906, 50.788}, {-0.864, 50.808},
+			{-0.905, 50.829}, {-0.929, 50.823}, {-0.919, 50.836}, {-0.939, 50.843}, {-1.021, 50.844},
+			{-1.056, 50.783}, {-1.083, 50.781}, {-1.103, 50.795}, {-1.069, 50.844}, {-1.165, 50.844},
+			{-1.124, 50.819}, {-1.125, 50.789}, {-1.151, 50.781}, {-1.466, 50.918}, {-1.323, 50.826},
+			{-1.317, 50.8}, {-1.419, 50.788}, {-1.405, 50.775}, {-1.488, 50.758}, {-1.525, 50.761},
+			{-1.561, 50.719}, {-1.693, 50.74}, {-1.772, 50.72}, {-1.857, 50.727}, {-1.94, 50.691},
+			{-1.949, 50.713}, {-2.022, 50.72}, {-2.039, 50.74}, {-2.083, 50.699}, {-2.036, 50.713},
+			{-2.003, 50.681}, {-1.953, 50.677}, {-1.953, 50.652}, {-1.932, 50.644}, {-1.967, 50.617},
+			{-1.965, 50.602}, {-2.065, 50.596}, {-2.157, 50.621}, {-2.398, 50.646}, {-2.436, 50.638},
+			{-2.46, 50.607}, {-2.466, 50.585}, {-2.429, 50.558}, {-2.453, 50.528}, {-2.46, 50.565},
+			{-2.484, 50.59}, {-2.693, 50.693}, {-2.865, 50.734}, {-3.26, 50.675}, {-3.296, 50.638},
+			{-3.368, 50.617}, {-3.421, 50.629}, {-3.464, 50.679}, {-3.433, 50.61}, {-3.482, 50.569},
+			{-3.506, 50.521}, {-3.487, 50.459}, {-3.532, 50.455}, {-3.556, 50.432}, {-3.549, 50.416},
+			{-3.487, 50.405}, {-3.527, 50.35}, {-3.571, 50.356}, {-3.578, 50.334}, {-3.632, 50.312},
+			{-3.653, 50.251}, {-3.646, 50.226}, {-3.661, 50.221}, {-3.789, 50.212}, {-3.961, 50.322},
+			{-4.033, 50.295}, {-4.054, 50.3}, {-4.07, 50.309}, {-4.064, 50.322}, {-4.108, 50.337},
+			{-4.119, 50.352}, {-4.105, 50.371}, {-4.171, 50.383}, {-4.191, 50.418}, {-4.16, 50.459},
+			{-4.201, 50.459}, {-4.235, 50.438}, {-4.216, 50.416}, {-4.229, 50.397}, {-4.29, 50.397},
+			{-4.273, 50.382}, {-4.194, 50.377}, {-4.229, 50.371}, {-4.18, 50.356}, {-4.198, 50.342},
+			{-4.194, 50.322}, {-4.252, 50.356}, {-4.338, 50.371}, {-4.495, 50.328}, {-4.673, 50.315},
+			{-4.694, 50.343}, {-4.763, 50.322}, {-4.755, 50.302}, {-4.78, 50.284}, {-4.777, 50.261},
+			{-4.8, 50.23}, {-4.85, 50.235}, {-4.947, 50.199}, {-5.002, 50.144}, {-5.02, 50.192},
+			{-5.053, 50.196}, {-5.065, 50.158}, {-5.043, 50.144}, {-5.126, 50.096}, {-5.071, 50.09},
+			{-5.057, 50.061}, {-5.098, 50.014}, {-5.146, 50.011}, {-5.191, 49.959}, {-5.245, 49.993},
+			{-5.253, 50.028}, {-5.304, 50.08}, {-5.475, 50.13}, {-5.541, 50.113}, {-5.535, 50.082},
+			{-5.563, 50.06}, {-5.667, 50.043}, {-5.705, 50.052}, {-5.716, 50.083}, {-5.694, 50.096},
+			{-5.709, 50.133}, {-5.685, 50.162}, {-5.511, 50.219}, {-5.438, 50.194}, {-5.388, 50.24},
+			{-5.315, 50.254}, {-5.15, 50.35}, {-5.154, 50.399}, {-5.05, 50.429}, {-5.016, 50.538},
+			{-4.947, 50.562}, {-4.934, 50.52}, {-4.845, 50.514}, {-4.922, 50.555}, {-4.921, 50.589},
+			{-4.792, 50.595}, {-4.749, 50.644}, {-4.752, 50.67}, {-4.657, 50.711}, {-4.638, 50.741},
+			{-4.577, 50.775}, {-4.55, 50.81}, {-4.555, 50.898}, {-4.523, 50.973}, {-4.526, 51.015},
+			{-4.338, 50.997}, {-4.208, 51.076}, {-4.256, 51.152}, {-4.215, 51.152}, {-4.21, 51.173},
+			{-4.229, 51.192}, {-3.769, 51.248}, {-3.434, 51.21}, {-3.385, 51.186}, {-3.029, 51.22},
+			{-3.036, 51.199}, {-3.022, 51.192}, {-3, 51.233}, {-3.022, 51.323}, {-2.992, 51.322},
+			{-2.96, 51.374}, {-2.975, 51.384}, {-2.921, 51.394}, {-2.799, 51.483}, {-2.716, 51.5},
+			{-2.58, 51.619}, {-2.556, 51.659}, {-2.465, 51.726}, {-2.389, 51.75}, {-2.383, 51.773},
+			{-2.398, 51.782}, {-2.42, 51.753}, {-2.482, 51.743},
+		},
+	},
+	{
+		{
+			{-1.083, 50.713}, {-1.062, 50.685}, {-1.156, 50.651}, {-1.168, 50.603}, {-1.279, 50.583},
+			{-1.486, 50.669}, {-1.569, 50.658}, {-1.506, 50.708}, {-1.419, 50.734}, {-1.425, 50.72},
+			{-1.401, 50.722}, {-1.319, 50.772}, {-1.109, 50.733},
+		},
+	},
+	{
+		{
+			{-0.96, 50.808}, {-0.96, 50.802}, {-0.939, 50.788}, {-0.939, 50.781}, {-1.021, 50.788},
+			{-1.021, 50.795}, {-0.999, 50.801}, {-0.973, 50.83}, {-0.96, 50.829}, {-0.95, 50.824},
+			{-0.948, 50.818}, {-0.951, 50.812},
+		},
+	},
+	{
+		{
+			{0.911, 51.412}, {0.942, 51.383}, {0.936, 51.371}, {0.911, 51.364}, {0.775, 51.375},
+			{0.734, 51.412}, {0.747, 51.44}, {0.767, 51.448},
+		},
+	},
+	{
+		{
+			{-4.577, 53.272}, {-4.558, 53.253}, {-4.564, 53.242}, {-4.582, 53.242}, {-4.602, 53.253},
+			{-4.633, 53.283}, {-4.677, 53.285}, {-4.694, 53.303}, {-4.694, 53.31}, {-4.667, 53.324},
+			{-4.656, 53.32}, {-4.626, 53.321}, {-4.59, 53.298}, {-4.581, 53.285},
+		},
+	},
+	{
+		{
+			{-4.098, 53.31}, {-4.043, 53.303}, {-4.098, 53.25}, {-4.196, 53.22}, {-4.222, 53.182},
+			{-4.28, 53.155}, {-4.354, 53.13}, {-4.407, 53.131}, {-4.412, 53.156}, {-4.385, 53.182},
+			{-4.448, 53.159}, {-4.495, 53.18}, {-4.5, 53.211}, {-4.571, 53.275}, {-4.559, 53.363},
+			{-4.571, 53.393}, {-4.425, 53.427}, {-4.325, 53.42}, {-4.277, 53.401}, {-4.267, 53.369},
+			{-4.235, 53.358}, {-4.203, 53.298},
+		},
+	},
+	{
+		{
+			{-5.071, 55.509}, {-5.093, 55.5}, {-5.078, 55.455}, {-5.118, 55.44}, {-5.217, 55.438},
+			{-5.305, 55.462}, {-5.346, 55.483}, {-5.359, 55.53}, {-5.346, 55.55}, {-5.389, 55.59},
+			{-5.398, 55.614}, {-5.393, 55.647}, {-5.355, 55.69}, {-5.291, 55.709}, {-5.297, 55.723},
+			{-5.198, 55.704}, {-5.146, 55.655}, {-5.126, 55.606}, {-5.137, 55.592}, {-5.094, 55.567},
+			{-5.092, 55.544}, {-5.125, 55.537}, {-5.12, 55.524},
+		},
+	},
+	{
+		{
+			{-5.03, 55.749}, {-5.011, 55.738}, {-5.009, 55.729}, {-5.016, 55.723}, {-5.029, 55.723},
+			{-5.05, 55.749}, {-5.116, 55.784}, {-5.14, 55.842}, {-5.181, 55.863}, {-5.201, 55.895},
+			{-5.2, 55.911}, {-5.167, 55.921}, {-5.106, 55.887}, {-5.081, 55.88}, {-5.075, 55.857},
+			{-5.05, 55.846}, {-5.022, 55.806}, {-5.011, 55.774},
+		},
+	},
+	{
+		{
+			{-6.03, 55.729}, {-6.024, 55.682}, {-6.058, 55.66}, {-6.132, 55.632}, {-6.215, 55.627},
+			{-6.227, 55.602}, {-6.287, 55.578}, {-6.311, 55.583}, {-6.325, 55.606}, {-6.319, 55.628},
+			{-6.243, 55.66}, {-6.277, 55.704}, {-6.325, 55.715}, {-6.321, 55.733}, {-6.25, 55.777},
+			{-6.329, 55.783}, {-6.387, 55.722}, {-6.463, 55.674}, {-6.504, 55.681}, {-6.49, 55.715},
+			{-6.504, 55.723}, {-6.449, 55.777}, {-6.482, 55.79}, {-6.461, 55.803}, {-6.445, 55.849},
+			{-6.331, 55.89}, {-6.318, 55.887}, {-6.338, 55.846}, {-6.311, 55.818}, {-6.318, 55.845},
+			{-6.297, 55.867}, {-6.14, 55.941}, {-6.12, 55.929}, {-6.099, 55.801}, {-6.05, 55.766},
+			{-6.058, 55.743},
+		},
+	},
+	{
+		{
+			{-6.206, 56.103}, {-6.198, 56.106}, {-6.183, 56.103}, {-6.174, 56.106}, {-6.182, 56.119},
+			{-6.138, 56.125}, {-6.133, 56.113}, {-6.166, 56.081}, {-6.177, 56.061}, {-6.174, 56.044},
+			{-6.194, 56.035}, {-6.222, 56.031}, {-6.249, 56.031}, {-6.27, 56.038}, {-6.254, 56.045},
+			{-6.233, 56.082},
+		},
+	},
+	{
+		{
+			{-5.798, 56.113}, {-5.716, 56.153}, {-5.694, 56.147}, {-5.689, 56.112}, {-5.74, 56.076},
+			{-5.886, 55.887}, {-5.945, 55.859}, {-5.962, 55.805}, {-6.027, 55.796}, {-6.062, 55.809},
+			{-6.087, 55.883}, {-6.075, 55.916}, {-5.997, 55.952}, {-5.919, 55.96}, {-5.886, 55.976},
+			{-5.981, 55.97}, {-5.996, 55.986}, {-5.983, 56.009}, {-5.924, 56.056},
+		},
+	},
+	{
+		{
+			{-5.688, 56.202}, {-5.681, 56.195}, {-5.674, 56.179}, {-5.694, 56.169}, {-5.725, 56.163},
+			{-5.749, 56.161}, {-5.738, 56.177}, {-5.722, 56.187},
+		},
+	},
+	{
+		{
+			{-5.633, 56.263}, {-5.623, 56.258}, {-5.618, 56.258}, {-5.613, 56.263}, {-5.611, 56.249},
+			{-5.628, 56.221}, {-5.637, 56.189}, {-5.648, 56.195}, {-5.667, 56.222}, {-5.656, 56.232},
+			{-5.645, 56.256},
+		},
+	},
+	{
+		{
+			{-5.633, 56.319}, {-5.621, 56.324}, {-5.598, 56.322}, {-5.585, 56.326}, {-5.59, 56.314},
+			{-5.6, 56.305}, {-5.605, 56.297}, {-5.599, 56.284}, {-5.622, 56.276}, {-5.64, 56.283},
+			{-5.647, 56.299},
+		},
+	},
+	{
+		{
+			{-5.558, 56.414}, {-5.527, 56.414}, {-5.512, 56.415}, {-5.503, 56.421}, {-5.518, 56.404},
+			{-5.536, 56.392}, {-5.558, 56.386}, {-5.585, 56.387}, {-5.574, 56.403},
+		},
+	},
+	{
+		{
+			{-6.16, 56.47}, {-6.237, 56.47}, {-6.264, 56.483}, {-6.242, 56.5}, {-6.215, 56.504},
+			{-6.171, 56.481},
+		},
+	},
+	{
+		{
+			{-6.819, 56.538}, {-6.742, 56.548}, {-6.729, 56.53}, {-6.743, 56.518}, {-6.804, 56.514},
+			{-6.806, 56.497}, {-6.869, 56.48}, {-6.888, 56.462}, {-6.882, 56.444}, {-6.965, 56.46},
+			{-6.99, 56.504},
+		},
+	},
+	{
+		{
+			{-5.688, 56.435}, {-5.658, 56.4}, {-5.703, 56.393}, {-5.742, 56.414}, {-5.739, 56.401},
+			{-5.784, 56.366}, {-5.712, 56.38}, {-5.696, 56.375}, {-5.703, 56.365}, {-5.809, 56.319},
+			{-5.88, 56.326}, {-5.854, 56.344}, {-5.873, 56.358}, {-6.066, 56.289}, {-6.102, 56.298},
+			{-6.24, 56.284}, {-6.267, 56.263}, {-6.328, 56.277}, {-6.366, 56.322}, {-6.31, 56.347},
+			{-6.247, 56.312}, {-6.229, 56.326}, {-6.25, 56.332}, {-6.085, 56.353}, {-6.021, 56.382},
+			{-6.154, 56.359}, {-6.203, 56.38}, {-6.157, 56.407}, {-6.127, 56.448}, {-6.01, 56.47},
+			{-6.003, 56.489}, {-6.017, 56.504}, {-6.127, 56.474}, {-6.174, 56.517}, {-6.338, 56.552},
+			{-6.29, 56.572}, {-6.325, 56.606}, {-6.256, 56.62}, {-6.23, 56.609}, {-6.22, 56.632},
+			{-6.151, 56.652}, {-6.058, 56.64}, {-6.064, 56.62}, {-6.009, 56.599}, {-5.963, 56.526},
+			{-5.794, 56.517}, {-5.757, 56.49}, {-5.71, 56.485}, {-5.681, 56.452}, {-5.648, 56.448},
+			{-5.654, 56.428},
+		},
+	},
+	{
+		{
+			{-6.459, 56.678}, {-6.497, 56.634}, {-6.527, 56.627}, {-6.564, 56.599}, {-6.616, 56.575},
+			{-6.669, 56.578}, {-6.678, 56.558}, {-6.702, 56.586}, {-6.674, 56.588}, {-6.648, 56.603},
+			{-6.591, 56.652}, {-6.559, 56.665}, {-6.49, 56.689}, {-6.453, 56.688},
+		},
+	},
+	{
+		{
+			{-7.394, 57.01}, {-7.382, 56.988}, {-7.374, 56.983}, {-7.411, 56.98}, {-7.442, 56.963},
+			{-7.437, 56.954}, {-7.445, 56.947}, {-7.477, 56.942}, {-7.557, 56.953}, {-7.556, 56.963},
+			{-7.545, 56.972}, {-7.517, 56.981}, {-7.512, 56.992}, {-7.514, 57.003}, {-7.524, 57.01},
+			{-7.457, 57.032}, {-7.449, 57.059}, {-7.414, 57.045}, {-7.435, 57.024},
+		},
+	},
+	{
+		{
+			{-6.325, 57.059}, {-6.251, 57.027}, {-6.27, 57.018}, {-6.27, 57.01}, {-6.243, 57.01},
+			{-6.257, 56.974}, {-6.276, 56.953}, {-6.304, 56.944}, {-6.342, 56.942}, {-6.36, 56.947},
+			{-6.376, 56.971}, {-6.455, 57.004}, {-6.364, 57.056},
+		},
+	},
+	{
+		{
+			{-6.606, 57.052}, {-6.556, 57.07}, {-6.537, 57.073}, {-6.49, 57.059}, {-6.516, 57.051},
+			{-6.548, 57.048},
+		},
+	},
+	{
+		{
+			{-5.959, 57.284}, {-5.985, 57.29}, {-6.008, 57.304}, {-6.014, 57.317}, {-5.99, 57.326},
+			{-5.956, 57.322}, {-5.929, 57.307}, {-5.925, 57.292},
+		},
+	},
+	{
+		{
+			{-7.244, 57.326}, {-7.212, 57.305}, {-7.195, 57.306}, {-7.2, 57.291}, {-7.233, 57.274},
+			{-7.257, 57.237}, {-7.276, 57.232}, {-7.353, 57.243}, {-7.271, 57.216}, {-7.26, 57.16},
+			{-7.271, 57.148}, {-7.308, 57.156}, {-7.346, 57.155}, {-7.346, 57.148}, {-7.277, 57.148},
+			{-7.216, 57.114}, {-7.239, 57.101}, {-7.322, 57.11}, {-7.353, 57.1}, {-7.391, 57.129},
+			{-7.417, 57.182}, {-7.414, 57.216}, {-7.455, 57.237}, {-7.39, 57.312}, {-7.428, 57.388},
+			{-7.353, 57.408}, {-7.264, 57.374}, {-7.298, 57.374}, {-7.298, 57.367}, {-7.223, 57.347},
+			{-7.223, 57.34}, {-7.25, 57.332}, {-7.272, 57.337}, {-7.371, 57.388}, {-7.394, 57.381},
+			{-7.37, 57.355}, {-7.322, 57.347}, {-7.29, 57.328},
+		},
+	},
+	{
+		{
+			{-7.264, 57.483}, {-7.271, 57.476}, {-7.263, 57.472}, {-7.236, 57.476}, {-7.202, 57.463},
+			{-7.208, 57.456}, {-7.222, 57.461}, {-7.245, 57.45}, {-7.264, 57.449}, {-7.25, 57.435},
+			{-7.212, 57.432}, {-7.204, 57.425}, {-7.205, 57.419}, {-7.292, 57.405}, {-7.318, 57.408},
+			{-7.396, 57.434}, {-7.408, 57.446}, {-7.403, 57.464}, {-7.389, 57.48}, {-7.37, 57.489},
+			{-7.322, 57.483}, {-7.298, 57.49}, {-7.284, 57.483},
+		},
+	},
+	{
+		{
+			{-6.064, 57.347}, {-6.075, 57.355}, {-6.077, 57.372}, {-6.069, 57.436}, {-6.062, 57.453},
+			{-6.046, 57.461}, {-6.017, 57.463}, {-6.017, 57.469}, {-6.037, 57.49}, {-6.016, 57.497},
+			{-5.996, 57.49}, {-5.996, 57.497}, {-6.01, 57.511}, {-5.993, 57.507}, {-5.983, 57.497},
+			{-6.018, 57.418}, {-6.017, 57.388}, {-5.995, 57.364}, {-5.989, 57.347}, {-6.055, 57.326},
+			{-6.072, 57.332},
+		},
+	},
+	{
+		{
+			{-5.968, 57.518}, {-5.984, 57.522}, {-5.99, 57.533}, {-5.987, 57.546}, {-5.976, 57.559},
+			{-5.98, 57.561}, {-5.983, 57.566}, {-5.969, 57.577}, {-5.962, 57.577}, {-5.955, 57.572},
+			{-5.968, 57.534},
+		},
+	},
+	{
+		{
+			{-7.129, 57.653}, {-7.093, 57.652}, {-7.065, 57.634}, {-7.099, 57.607}, {-7.171, 57.641},
+			{-7.216, 57.634}, {-7.197, 57.631}, {-7.202, 57.613}, {-7.164, 57.61}, {-7.154, 57.587},
+			{-7.106, 57.593}, {-7.126, 57.571}, {-7.236, 57.552}, {-7.257, 57.572}, {-7.312, 57.552},
+			{-7.144, 57.549}, {-7.134, 57.538}, {-7.158, 57.511}, {-7.305, 57.511}, {-7.346, 57.545},
+			{-7.318, 57.552}, {-7.442, 57.579}, {-7.471, 57.567}, {-7.545, 57.593}, {-7.477, 57.662},
+			{-7.387, 57.662}, {-7.414, 57.648}, {-7.397, 57.635}, {-7.312, 57.689}, {-7.333, 57.662},
+			{-7.278, 57.65}, {-7.257, 57.648}, {-7.277, 57.662}, {-7.182, 57.689}, {-7.165, 57.67},
+			{-7.189, 57.655},
+		},
+	},
+	{
+		{
+			{-6.564, 57.347}, {-6.696, 57.364}, {-6.732, 57.417}, {-6.773, 57.434}, {-6.784, 57.456},
+			{-6.722, 57.456}, {-6.747, 57.488}, {-6.716, 57.514}, {-6.607, 57.444}, {-6.613, 57.463},
+			{-6.599, 57.469}, {-6.631, 57.502}, {-6.564, 57.504}, {-6.599, 57.538}, {-6.655, 57.552},
+			{-6.631, 57.577}, {-6.64, 57.607}, {-6.572, 57.583}, {-6.555, 57.552}, {-6.465, 57.513},
+			{-6.421, 57.518}, {-6.443, 57.488}, {-6.4, 57.521}, {-6.311, 57.463}, {-6.325, 57.497},
+			{-6.39, 57.552}, {-6.36, 57.593}, {-6.4, 57.6}, {-6.416, 57.646}, {-6.311, 57.703},
+			{-6.154, 57.59}, {-6.133, 57.476}, {-6.147, 57.425}, {-6.188, 57.394}, {-6.127, 57.408},
+			{-6.118, 57.398}, {-6.14, 57.374}, {-6.135, 57.357}, {-6.099, 57.332}, {-6.154, 57.306},
+			{-6.045, 57.308}, {-6.078, 57.278}, {-6.008, 57.287}, {-5.923, 57.267}, {-5.901, 57.243},
+			{-5.768, 57.277}, {-5.647, 57.265}, {-5.663, 57.213}, {-5.691, 57.19}, {-5.782, 57.166},
+			{-5.8, 57.124}, {-5.935, 57.041}, {-6.007, 57.028}, {-6.03, 57.045}, {-6.027, 57.062},
+			{-5.987, 57.118}, {-5.942, 57.155}, {-5.845, 57.188}, {-5.986, 57.175}, {-6.044, 57.23},
+			{-6.024, 57.196}, {-6.089, 57.127}, {-6.113, 57.188}, {-6.164, 57.201}, {-6.174, 57.196},
+			{-6.16, 57.188}, {-6.177, 57.181}, {-6.318, 57.161}, {-6.277, 57.202}, {-6.346, 57.188},
+			{-6.38, 57.223}, {-6.346, 57.243}, {-6.408, 57.243}, {-6.482, 57.312}, {-6.416, 57.335},
+			{-6.338, 57.301}, {-6.311, 57.298}, {-6.324, 57.311}, {-6.406, 57.352}, {-6.463, 57.347},
+			{-6.449, 57.36}, {-6.476, 57.367}, {-6.463, 57.374}, {-6.479, 57.382}, {-6.476, 57.401},
+			{-6.524, 57.381}, {-6.531, 57.415}, {-6.572, 57.388},
+		},
+	},
+	{
+		{
+			{-7.223, 57.71}, {-7.18, 57.735}, {-7.161, 57.737}, {-7.152, 57.726}, {-7.17, 57.715},
+			{-7.199, 57.708},
+		},
+	},
+	{
+		{
+			{-8.621, 57.833}, {-8.605, 57.826}, {-8.569, 57.824}, {-8.552, 57.819}, {-8.569, 57.808},
+			{-8.591, 57.805}, {-8.611, 57.813},
+		},
+	},
+	{
+		{
+			{-7.045, 57.902}, {-7.048, 57.891}, {-7.055, 57.884}, {-7.066, 57.881}, {-7.079, 57.88},
+			{-7.077, 57.891}, {-7.079, 57.895}, {-7.038, 57.916}, {-7.016, 57.922}, {-6.997, 57.915},
+			{-6.997, 57.894}, {-7.009, 57.89},
+		},
+	},
+	{
+		{
+			{-6.873, 58.217}, {-6.86, 58.217}, {-6.86, 58.223}, {-6.872, 58.227}, {-6.882, 58.236},
+			{-6.886, 58.248}, {-6.88, 58.258}, {-6.861, 58.253}, {-6.829, 58.234}, {-6.819, 58.237},
+			{-6.802, 58.226}, {-6.791, 58.21}, {-6.873, 58.21},
+		},
+	},
+	{
+		{
+			{-6.955, 57.744}, {-6.981, 57.735}, {-7.113, 57.816}, {-7.125, 57.837}, {-7.097, 57.838},
+			{-7.079, 57.813}, {-6.985, 57.865}, {-6.914, 57.868}, {-6.963, 57.895}, {-6.882, 57.914},
+			{-6.832, 57.902}, {-6.866, 57.921}, {-6.839, 57.936}, {-7.01, 57.955}, {-7.113, 57.99},
+			{-7.052, 58.011}, {-7.058, 58.025}, {-6.935, 58.052}, {-7.058, 58.039}, {-7.024, 58.052},
+			{-7.052, 58.059}, {-7.024, 58.08}, {-7.076, 58.062}, {-7.113, 58.1}, {-7.123, 58.137},
+			{-7.093, 58.168}, {-7.113, 58.182}, {-7.017, 58.189}, {-7.058, 58.213}, {-7.031, 58.245},
+			{-6.907, 58.217}, {-6.937, 58.211}, {-6.86, 58.107}, {-6.86, 58.135}, {-6.888, 58.168},
+			{-6.869, 58.189}, {-6.798, 58.202}, {-6.737, 58.168}, {-6.709, 58.189}, {-6.743, 58.203},
+			{-6.757, 58.23}, {-6.784, 58.23}, {-6.812, 58.265}, {-6.791, 58.271}, {-6.819, 58.285},
+			{-6.716, 58.34}, {-6.557, 58.369}, {-6.267, 58.512}, {-6.223, 58.499}, {-6.167, 58.42},
+			{-6.192, 58.409}, {-6.215, 58.368}, {-6.167, 58.347}, {-6.318, 58.268}, {-6.318, 58.245},
+			{-6.366, 58.237}, {-6.348, 58.221}, {-6.271, 58.216}, {-6.151, 58.258}, {-6.167, 58.23},
+			{-6.16, 58.217}, {-6.234, 58.184}, {-6.4, 58.21}, {-6.366, 58.162}, {-6.373, 58.141},
+			{-6.49, 58.1}, {-6.524, 58.107}, {-6.619, 58.086}, {-6.581, 58.079}, {-6.407, 58.107},
+			{-6.366, 58.059}, {-6.4, 58.045}, {-6.352, 58.039}, {-6.424, 58.004}, {-6.545, 58.018},
+			{-6.455, 57.977}, {-6.508, 57.936}, {-6.551, 57.943}, {-6.537, 57.921}, {-6.578, 57.917},
+			{-6.63, 57.957}, {-6.619, 57.915}, {-6.661, 57.929}, {-6.709, 58.004}, {-6.666, 58.045},
+			{-6.592, 58.059}, {-6.688, 58.059}, {-6.693, 58.041}, {-6.764, 58.004}, {-6.674, 57.929},
+			{-6.696, 57.921}, {-6.668, 57.908}, {-6.661, 57.88}, {-6.787, 57.899}, {-6.806, 57.88},
+			{-6.764, 57.868}, {-6.742, 57.831}, {-6.791, 57.826}, {-6.784, 57.813}, {-6.853, 57.833},
+			{-6.839, 57.813}, {-6.888, 57.785}, {-6.873, 57.778},
+		},
+	},
+	{
+		{
+			{-2.953, 58.84}, {-2.907, 58.838}, {-2.884, 58.832}, {-2.885, 58.82}, {-2.919, 58.806},
+			{-2.921, 58.798}, {-2.914, 58.785}, {-2.926, 58.779}, {-2.911, 58.749}, {-2.918, 58.738},
+			{-2.94, 58.731}, {-2.988, 58.757}, {-2.988, 58.765}, {-2.974, 58.772}, {-2.985, 58.787},
+			{-3.016, 58.813}, {-2.979, 58.808}, {-2.967, 58.813}, {-2.967, 58.82}, {-3.036, 58.82},
+			{-3.036, 58.826},
+		},
+	},
+	{
+		{
+			{-3.248, 58.792}, {-3.132, 58.806}, {-3.156, 58.787}, {-3.299, 58.779}, {-3.328, 58.818},
+			{-3.379, 58.854}, {-3.379, 58.874}, {-3.43, 58.875}, {-3.433, 58.885}, {-3.416, 58.908},
+			{-3.349, 58.929}, {-3.246, 58.896}, {-3.193, 58.854}, {-3.207, 58.84}, {-3.187, 58.836},
+			{-3.173, 58.82}, {-3.215, 58.814},
+		},
+	},
+	{
+		{
+			{-2.728, 58.971}, {-2.708, 58.959}, {-2.707, 58.932}, {-2.785, 58.911}, {-2.824, 58.882},
+			{-2.927, 58.905}, {-2.981, 58.96}, {-3.024, 58.94}, {-3.085, 58.943}, {-3.07, 58.929},
+			{-3.118, 58.916}, {-3.203, 58.916}, {-3.239, 58.951}, {-3.207, 59.005}, {-3.228, 59.039},
+			{-3.246, 59.031}, {-3.241, 59.011}, {-3.276, 59.011}, {-3.257, 58.978}, {-3.289, 58.95},
+			{-3.323, 58.957}, {-3.367, 59.016}, {-3.344, 59.053}, {-3.35, 59.098}, {-3.324, 59.127},
+			{-3.183, 59.147}, {-3.122, 59.124}, {-3.085, 59.128}, {-3.049, 59.108}, {-3.07, 59.101},
+			{-2.994, 59.073}, {-3.008, 59.067}, {-2.994, 59.06}, {-3.063, 59.049}, {-3.091, 59.019},
+			{-3.132, 59.005}, {-3.117, 58.998}, {-3.06, 58.991}, {-3.012, 59.017}, {-2.974, 58.999},
+			{-2.933, 59.011}, {-2.895, 58.991}, {-2.878, 58.998}, {-2.885, 58.977}, {-2.781, 58.99},
+			{-2.782, 58.974}, {-2.838, 58.95}, {-2.795, 58.926}, {-2.775, 58.929}, {-2.796, 58.957},
+		},
+	},
+	{
+		{
+			{-2.542, 59.121}, {-2.548, 59.109}, {-2.542, 59.103}, {-2.515, 59.094}, {-2.533, 59.084},
+			{-2.597, 59.073}, {-2.599, 59.096}, {-2.614, 59.107}, {-2.634, 59.109}, {-2.652, 59.101},
+			{-2.649, 59.086}, {-2.671, 59.082}, {-2.686, 59.089}, {-2.666, 59.108}, {-2.622, 59.116},
+			{-2.618, 59.128}, {-2.666, 59.155}, {-2.612, 59.161}, {-2.618, 59.148}, {-2.59, 59.149},
+			{-2.569, 59.142}, {-2.582, 59.144}, {-2.593, 59.139}, {-2.595, 59.13}, {-2.584, 59.121},
+			{-2.572, 59.119}, {-2.535, 59.128}, {-2.535, 59.123},
+		},
+	},
+	{
+		{
+			{-3.005, 59.128}, {-3.066, 59.134}, {-3.092, 59.143}, {-3.115, 59.158}, {-3.12, 59.177},
+			{-3.102, 59.19}, {-3.074, 59.197}, {-3.049, 59.197}, {-3.043, 59.186}, {-3.029, 59.182},
+			{-2.981, 59.187}, {-2.953, 59.183}, {-2.954, 59.177}, {-2.977, 59.163}, {-2.975, 59.134},
+		},
+	},
+	{
+		{
+			{-2.83, 59.197}, {-2.817, 59.191}, {-2.786, 59.197}, {-2.792, 59.237}, {-2.788, 59.247},
+			{-2.777, 59.253}, {-2.761, 59.252}, {-2.761, 59.245}, {-2.769, 59.245}, {-2.769, 59.238},
+			{-2.744, 59.238}, {-2.734, 59.233}, {-2.728, 59.224}, {-2.761, 59.193}, {-2.759, 59.164},
+			{-2.751, 59.156}, {-2.734, 59.155}, {-2.734, 59.148}, {-2.781, 59.141}, {-2.789, 59.145},
+			{-2.793, 59.159}, {-2.802, 59.168}, {-2.83, 59.176},
+		},
+	},
+	{
+		{
+			{-2.446, 59.293}, {-2.411, 59.319}, {-2.392, 59.285}, {-2.459, 59.279}, {-2.505, 59.233},
+			{-2.515, 59.23}, {-2.516, 59.252}, {-2.533, 59.251}, {-2.577, 59.224}, {-2.569, 59.245},
+			{-2.604, 59.245}, {-2.662, 59.207}, {-2.679, 59.21}, {-2.682, 59.196}, {-2.693, 59.192},
+			{-2.688, 59.218}, {-2.625, 59.258}, {-2.596, 59.265}, {-2.591, 59.279}, {-2.612, 59.293},
+			{-2.522, 59.307}, {-2.522, 59.299}, {-2.577, 59.271}, {-2.563, 59.265}, {-2.492, 59.289},
+		},
+	},
+	{
+		{
+			{-3.049, 59.32}, {-3.073, 59.325}, {-3.077, 59.334}, {-3.008, 59.334}, {-2.965, 59.361},
+			{-2.946, 59.35}, {-2.964, 59.34}, {-2.96, 59.327}, {-2.988, 59.327}, {-2.988, 59.32},
+			{-2.895, 59.303}, {-2.871, 59.271}, {-2.839, 59.265}, {-2.83, 59.252}, {-2.85, 59.252},
+			{-2.885, 59.23}, {-2.871, 59.258}, {-2.947, 59.291}, {-2.975, 59.293}, {-2.96, 59.271},
+			{-2.975, 59.279}, {-2.99, 59.267}, {-3.008, 59.271},
+		},
+	},
+	{
+		{
+			{-1.651, 59.529}, {-1.644, 59.546}, {-1.625, 59.556}, {-1.603, 59.56}, {-1.583, 59.56},
+			{-1.584, 59.554}, {-1.604, 59.551}, {-1.608, 59.547}, {-1.61, 59.539}, {-1.597, 59.539},
+			{-1.597, 59.532}, {-1.613, 59.527}, {-1.631, 59.517}, {-1.645, 59.514},
+		},
+	},
+	{
+		{
+			{-0.785, 60.615}, {-0.758, 60.608}, {-0.755, 60.599}, {-0.761, 60.588}, {-0.785, 60.576},
+			{-0.842, 60.588}, {-0.87, 60.588}, {-0.87, 60.58}, {-0.856, 60.567}, {-0.884, 60.571},
+			{-0.939, 60.621}, {-0.939, 60.629}, {-0.822, 60.629}, {-0.812, 60.626}, {-0.809, 60.608},
+		},
+	},
+	{
+		{
+			{-1.097, 60.416}, {-1.073, 60.445}, {-1.034, 60.451}, {-1.125, 60.384}, {-1.118, 60.374},
+			{-1.068, 60.396}, {-1.07, 60.36}, {-1.172, 60.348}, {-1.075, 60.321}, {-1.138, 60.291},
+			{-1.134, 60.276}, {-1.09, 60.273}, {-1.148, 60.249}, {-1.193, 60.273}, {-1.185, 60.245},
+			{-1.219, 60.238}, {-1.179, 60.232}, {-1.199, 60.204}, {-1.158, 60.225}, {-1.193, 60.176},
+			{-1.155, 60.193}, {-1.144, 60.188}, {-1.158, 60.17}, {-1.124, 60.15}, {-1.199, 60.129},
+			{-1.19, 60.113}, {-1.213, 60.107}, {-1.185, 60.094}, {-1.202, 60.083}, {-1.193, 60.07},
+			{-1.165, 60.06}, {-1.185, 60.038}, {-1.219, 60.04}, {-1.193, 59.998}, {-1.201, 59.986},
+			{-1.247, 59.998}, {-1.245, 59.956}, {-1.275, 59.93}, {-1.263, 59.907}, {-1.275, 59.882},
+			{-1.261, 59.866}, {-1.275, 59.854}, {-1.295, 59.875}, {-1.311, 59.866}, {-1.301, 59.889},
+			{-1.376, 59.909}, {-1.356, 59.945}, {-1.323, 59.951}, {-1.35, 59.978}, {-1.323, 59.978},
+			{-1.329, 59.996}, {-1.263, 60.115}, {-1.268, 60.142}, {-1.289, 60.156}, {-1.269, 60.185},
+			{-1.312, 60.181}, {-1.268, 60.245}, {-1.287, 60.246}, {-1.329, 60.204}, {-1.358, 60.244},
+			{-1.411, 60.259}, {-1.35, 60.211}, {-1.357, 60.197}, {-1.398, 60.211}, {-1.405, 60.18},
+			{-1.419, 60.191}, {-1.455, 60.156}, {-1.527, 60.193}, {-1.466, 60.217}, {-1.504, 60.243},
+			{-1.525, 60.212}, {-1.548, 60.23}, {-1.625, 60.217}, {-1.67, 60.24}, {-1.69, 60.285},
+			{-1.639, 60.311}, {-1.548, 60.293}, {-1.556, 60.307}, {-1.521, 60.3}, {-1.528, 60.313},
+			{-1.481, 60.316}, {-1.466, 60.279}, {-1.446, 60.286}, {-1.46, 60.307}, {-1.423, 60.326},
+			{-1.374, 60.308}, {-1.364, 60.286}, {-1.336, 60.307}, {-1.364, 60.321}, {-1.343, 60.348},
+			{-1.26, 60.355}, {-1.338, 60.374}, {-1.343, 60.396}, {-1.373, 60.381}, {-1.395, 60.39},
+			{-1.384, 60.423}, {-1.439, 60.431}, {-1.446, 60.492}, {-1.48, 60.457}, {-1.499, 60.469},
+			{-1.48, 60.485}, {-1.609, 60.483}, {-1.586, 60.512}, {-1.556, 60.512}, {-1.527, 60.557},
+			{-1.455, 60.509}, {-1.384, 60.512}, {-1.48, 60.539}, {-1.453, 60.567}, {-1.411, 60.58},
+			{-1.425, 60.588}, {-1.419, 60.608}, {-1.367, 60.612}, {-1.316, 60.594}, {-1.324, 60.621},
+			{-1.301, 60.643}, {-1.292, 60.6}, {-1.309, 60.588}, {-1.301, 60.565}, {-1.336, 60.533},
+			{-1.316, 60.533}, {-1.336, 60.526}, {-1.316, 60.519}, {-1.336, 60.485}, {-1.295, 60.492},
+			{-1.309, 60.457}, {-1.37, 60.402}, {-1.323, 60.41}, {-1.324, 60.428}, {-1.297, 60.438},
+			{-1.234, 60.444}, {-1.295, 60.471}, {-1.272, 60.484}, {-1.26, 60.465}, {-1.218, 60.498},
+			{-1.186, 60.482}, {-1.193, 60.471}, {-1.17, 60.463}, {-1.152, 60.431}, {-1.186, 60.432},
+			{-1.221, 60.409}, {-1.165, 60.412}, {-1.144, 60.389}, {-1.103, 60.431},
+		},
+	},
+	{
+		{
+			{-0.987, 60.698}, {-0.997, 60.676}, {-0.98, 60.657}, {-0.993, 60.636}, {-1.043, 60.662},
+			{-1.062, 60.663}, {-1.014, 60.615}, {-1.041, 60.608}, {-1.069, 60.615}, {-1.052, 60.598},
+			{-1.021, 60.594}, {-1.028, 60.588}, {-1.011, 60.578}, {-1.011, 60.565}, {-1.023, 60.556},
+			{-1.042, 60.553}, {-1.022, 60.527}, {-1.024, 60.503}, {-1.08, 60.505}, {-1.101, 60.515},
+			{-1.108, 60.505}, {-1.097, 60.492}, {-1.131, 60.496}, {-1.154, 60.508}, {-1.168, 60.53},
+			{-1.18, 60.625}, {-1.171, 60.646}, {-1.138, 60.649}, {-1.141, 60.631}, {-1.13, 60.619},
+			{-1.103, 60.615}, {-1.124, 60.66}, {-1.111, 60.681}, {-1.12, 60.697}, {-1.114, 60.715},
+			{-1.092, 60.735}, {-1.08, 60.736}, {-1.069, 60.718}, {-1.058, 60.73}, {-0.993, 60.731},
+			{-1.001, 60.704},
+		},
+	},
+	{
+		{
+			{-0.905, 60.684}, {-0.935, 60.682}, {-0.966, 60.69}, {-0.96, 60.709}, {-0.966, 60.725},
+			{-0.949, 60.718}, {-0.943, 60.726}, {-0.952, 60.752}, {-0.936, 60.756}, {-0.926, 60.77},
+			{-0.926, 60.785}, {-0.939, 60.793}, {-0.939, 60.8}, {-0.904, 60.814}, {-0.891, 60.826},
+			{-0.891, 60.835}, {-0.881, 60.845}, {-0.86, 60.838}, {-0.856, 60.824}, {-0.86, 60.813},
+			{-0.882, 60.801}, {-0.888, 60.79}, {-0.853, 60.804}, {-0.822, 60.848}, {-0.772, 60.833},
+			{-0.761, 60.813}, {-0.788, 60.807}, {-0.761, 60.8}, {-0.808, 60.779}, {-0.802, 60.759},
+			{-0.821, 60.759}, {-0.802, 60.752}, {-0.802, 60.745}, {-0.85, 60.718}, {-0.822, 60.685},
+			{-0.846, 60.676}, {-0.87, 60.684},
+		},
+	},
+	{
+		{
+			{-6.299, 49.935}, {-6.292, 49.932}, {-6.284, 49.923}, {-6.288, 49.914}, {-6.297, 49.91},
+			{-6.309, 49.914}, {-6.307, 49.927},
+		},
+	},
+	{
+		{
+			{-6.334, 49.96}, {-6.328, 49.957}, {-6.326, 49.949}, {-6.333, 49.942}, {-6.341, 49.945},
+			{-6.348, 49.956}, {-6.349, 49.964}, {-6.342, 49.964},
+		},
+	},
+	{
+		{
+			{-4.665, 51.184}, {-4.664, 51.177}, {-4.663, 51.165}, {-4.67, 51.16}, {-4.679, 51.167},
+			{-4.68, 51.181}, {-4.676, 51.193}, {-4.671, 51.195},
+		},
+	},
+	{
+		{
+			{-1.315, 60.094}, {-1.308, 60.094}, {-1.305, 60.087}, {-1.327, 60.051}, {-1.334, 60.044},
+			{-1.339, 60.044}, {-1.334, 60.062}, {-1.335, 60.076}, {-1.338, 60.064}, {-1.354, 60.051},
+			{-1.368, 60.045}, {-1.37, 60.049}, {-1.357, 60.058}, {-1.348, 60.083}, {-1.338, 60.093},
+			{-1.34, 60.1}, {-1.347, 60.105}, {-1.346, 60.109}, {-1.316, 60.115}, {-1.315, 60.106},
+			{-1.323, 60.088},
+		},
+	},
+	{
+		{
+			{-2.053, 60.156}, {-2.049, 60.147}, {-2.048, 60.129}, {-2.063, 60.118}, {-2.087, 60.123},
+			{-2.105, 60.132}, {-2.112, 60.138}, {-2.11, 60.145}, {-2.103, 60.15}, {-2.088, 60.155},
+			{-2.065, 60.157},
+		},
+	},
+	{
+		{
+			{-1.063, 60.164}, {-1.051, 60.17}, {-1.053, 60.148}, {-1.067, 60.129}, {-1.074, 60.108},
+			{-1.084, 60.105}, {-1.113, 60.116}, {-1.122, 60.123}, {-1.119, 60.156}, {-1.136, 60.168},
+			{-1.135, 60.179}, {-1.116, 60.18}, {-1.098, 60.176}, {-1.09, 60.178}, {-1.086, 60.186},
+			{-1.079, 60.188}, {-1.075, 60.183}, {-1.07, 60.164},
+		},
+	},
+	{
+		{
+			{-0.919, 60.386}, {-0.913, 60.387}, {-0.911, 60.382}, {-0.918, 60.377}, {-0.976, 60.34},
+			{-1.015, 60.334}, {-1.034, 60.34}, {-1.006, 60.369}, {-0.984, 60.372}, {-0.968, 60.381},
+		},
+	},
+	{
+		{
+			{-4.612, 54.057}, {-4.621, 54.07}, {-4.656, 54.064}, {-4.704, 54.085}, {-4.766, 54.059},
+			{-4.79, 54.064}, {-4.728, 54.143}, {-4.71, 54.221}, {-4.612, 54.265}, {-4.531, 54.366},
+			{-4.495, 54.386}, {-4.351, 54.414}, {-4.376, 54.348}, {-4.358, 54.318}, {-4.325, 54.31},
+			{-4.312, 54.287}, {-4.366, 54.235}, {-4.399, 54.222}, {-4.391, 54.195}, {-4.55, 54.101},
+			{-4.591, 54.091},
+		},
+	},
+})
+
+// austria is Austria.
+var austria = newBoundary(MultiPolygon{
+	{
+		{
+			{16.945, 48.604}, {16.954, 48.557}, {16.947, 48.54}, {16.914, 48.519}, {16.901, 48.497},
+			{16.865, 48.458}, {16.844, 48.366}, {16.848, 48.36}, {16.875, 48.355}, {16.902, 48.339},
+			{16.906, 48.331}, {16.898, 48.316}, {16.905, 48.312}, {16.917, 48.291}, {16.954, 48.273},
+			{16.954, 48.253}, {16.97, 48.217}, {16.975, 48.199}, {16.975, 48.177}, {16.982, 48.161},
+			{17.007, 48.143}, {17.047, 48.131}, {17.067, 48.107}, {17.08, 48.098}, {17.07, 48.089},
+			{17.059, 48.06}, {17.075, 48.052}, {17.07, 48.036}, {17.125, 48.02}, {17.148, 48.005},
+			{17.086, 47.97}, {17.096, 47.962}, {17.096, 47.956}, {17.088, 47.941}, {17.074, 47.932},
+			{17.075, 47.928}, {17.087, 47.923}, {17.078, 47.892}, {17.068, 47.882}, {17.051, 47.873},
+			{17.004, 47.863}, {17.004, 47.852}, {17.04, 47.837}, {17.049, 47.819}, {17.056, 47.812},
+			{17.041, 47.801}, {17.042, 47.784}, {17.049, 47.764}, {17.051, 47.731}, {17.056, 47.721},
+			{17.075, 47.708}, {17.055, 47.702}, {16.982, 47.695}, {16.903, 47.682}, {16.865, 47.687},
+			{16.85, 47.713}, {16.837, 47.705}, {16.817, 47.684}, {16.806, 47.677}, {16.797, 47.675},
+			{16.741, 47.681}, {16.73, 47.686}, {16.719, 47.694}, {16.702, 47.724}, {16.69, 47.73},
+			{16.61, 47.751}, {16.568, 47.754}, {16.531, 47.743}, {16.525, 47.733}, {16.527, 47.72},
+			{16.521, 47.712}, {16.513, 47.706}, {16.473, 47.692}, {16.455, 47.682}, {16.439, 47.69},
+			{16.408, 47.661}, {16.482, 47.639}, {16.51, 47.643}, {16.575, 47.625}, {16.608, 47.629},
+			{16.63, 47.622}, {16.648, 47.606}, {16.656, 47.586}, {16.651, 47.567}, {16.668, 47.56},
+			{16.681, 47.551}, {16.689, 47.538}, {16.688, 47.523}, {16.677, 47.51}, {16.648, 47.502},
+			{16.637, 47.493}, {16.641, 47.453}, {16.589, 47.426}, {16.482, 47.392}, {16.47, 47.406},
+			{16.457, 47.412}, {16.444, 47.41}, {16.434, 47.397}, {16.436, 47.359}, {16.425, 47.351},
+			{16.424, 47.345}, {16.439, 47.337}, {16.469, 47.293}, {16.473, 47.277}, {16.467, 47.263},
+			{16.452, 47.255}, {16.421, 47.243}, {16.425, 47.226}, {16.409, 47.204}, {16.413, 47.187},
+			{16.419, 47.184}, {16.435, 47.184}, {16.442, 47.177}, {16.442, 47.168}, {16.434, 47.146},
+			{16.447, 47.14}, {16.481, 47.151}, {16.497, 47.15}, {16.51, 47.138}, {16.505, 47.126},
+			{16.482, 47.105}, {16.461, 47.096}, {16.454, 47.082}, {16.462, 47.068}, {16.494, 47.06},
+			{16.497, 47.055}, {16.493, 47.049}, {16.437, 47.032}, {16.425, 47.024}, {16.468, 47.018},
+			{16.482, 47.009}, {16.486, 46.999}, {16.425, 46.993}, {16.416, 46.989}, {16.388, 47.002},
+			{16.367, 47.004}, {16.326, 47}, {16.289, 47.006}, {16.275, 47.004}, {16.265, 46.993},
+			{16.261, 46.978}, {16.233, 46.966}, {16.231, 46.948}, {16.224, 46.941}, {16.171, 46.919},
+			{16.11, 46.868}, {16.094, 46.863}, {16.053, 46.846}, {16.032, 46.838}, {15.988, 46.83},
+			{15.972, 46.821}, {15.977, 46.816}, {15.978, 46.809}, {15.971, 46.778}, {15.971, 46.743},
+			{15.982, 46.719}, {16.003, 46.709}, {16.015, 46.694}, {16.017, 46.671}, {15.998, 46.687},
+			{15.987, 46.692}, {15.946, 46.697}, {15.879, 46.721}, {15.851, 46.724}, {15.823, 46.723},
+			{15.755, 46.704}, {15.729, 46.703}, {15.652, 46.711}, {15.636, 46.718}, {15.633, 46.69},
+			{15.627, 46.681}, {15.604, 46.673}, {15.567, 46.676}, {15.546, 46.672}, {15.531, 46.664},
+			{15.511, 46.628}, {15.493, 46.618}, {15.463, 46.615}, {15.418, 46.638}, {15.388, 46.646},
+			{15.205, 46.639}, {15.062, 46.65}, {15.004, 46.637}, {14.967, 46.6}, {14.948, 46.619},
+			{14.934, 46.621}, {14.898, 46.606}, {14.863, 46.605}, {14.85, 46.601}, {14.834, 46.584},
+			{14.822, 46.568}, {14.807, 46.536}, {14.789, 46.507}, {14.761, 46.496}, {14.735, 46.493},
+			{14.726, 46.498}, {14.71, 46.493}, {14.687, 46.471}, {14.68, 46.459}, {14.662, 46.46},
+			{14.632, 46.441}, {14.59, 46.434}, {14.575, 46.42}, {14.558, 46.384}, {14.54, 46.379},
+			{14.527, 46.388}, {14.516, 46.405}, {14.502, 46.418}, {14.468, 46.413}, {14.451, 46.414},
+			{14.42, 46.424}, {14.406, 46.439}, {14.396, 46.441}, {14.362, 46.436}, {14.15, 46.44},
+			{14.137, 46.442}, {14.081, 46.476}, {14.05, 46.484}, {13.982, 46.482}, {13.891, 46.512},
+			{13.861, 46.515}, {13.782, 46.508}, {13.716, 46.519}, {13.701, 46.52}, {13.685, 46.518},
+			{13.67, 46.519}, {13.549, 46.546}, {13.507, 46.547}, {13.478, 46.564}, {13.417, 46.56},
+			{13.373, 46.566}, {13.271, 46.551}, {13.231, 46.552}, {13.21, 46.558}, {13.146, 46.585},
+			{13.065, 46.598}, {12.83, 46.61}, {12.774, 46.635}, {12.74, 46.643}, {12.707, 46.638},
+			{12.67, 46.653}, {12.62, 46.656}, {12.547, 46.652}, {12.5, 46.672}, {12.446, 46.679},
+			{12.405, 46.69}, {12.37, 46.711}, {12.351, 46.743}, {12.343, 46.765}, {12.326, 46.772},
+			{12.284, 46.78}, {12.269, 46.789}, {12.267, 46.808}, {12.276, 46.834}, {12.276, 46.846},
+			{12.267, 46.868}, {12.251, 46.876}, {12.209, 46.877}, {12.195, 46.88}, {12.172, 46.899},
+			{12.127, 46.909}, {12.142, 46.919}, {12.142, 46.928}, {12.128, 46.949}, {12.118, 46.983},
+			{12.111, 46.993}, {12.122, 47.011}, {12.182, 47.034}, {12.204, 47.053}, {12.204, 47.08},
+			{12.181, 47.085}, {12.116, 47.077}, {12.015, 47.04}, {11.944, 47.038}, {11.9, 47.028},
+			{11.857, 47.012}, {11.823, 46.993}, {11.777, 46.988}, {11.746, 46.972}, {11.735, 46.971},
+			{11.716, 46.975}, {11.684, 46.992}, {11.65, 46.993}, {11.596, 47}, {11.573, 46.999},
+			{11.524, 46.988}, {11.507, 46.993}, {11.489, 47.004}, {11.472, 47.007}, {11.453, 47.001},
+			{11.411, 46.97}, {11.381, 46.972}, {11.349, 46.982}, {11.314, 46.987}, {11.244, 46.979},
+			{11.174, 46.964}, {11.157, 46.957}, {11.092, 46.912}, {11.084, 46.9}, {11.073, 46.865},
+			{11.054, 46.834}, {11.053, 46.815}, {11.033, 46.806}, {11.011, 46.779}, {10.997, 46.769},
+			{10.983, 46.768}, {10.931, 46.774}, {10.87, 46.764}, {10.834, 46.78}, {10.795, 46.777},
+			{10.755, 46.791}, {10.723, 46.786}, {10.717, 46.795}, {10.744, 46.813}, {10.749, 46.819},
+			{10.739, 46.83}, {10.662, 46.861}, {10.647, 46.864}, {10.528, 46.843}, {10.486, 46.846},
+			{10.454, 46.864}, {10.451, 46.886}, {10.464, 46.92}, {10.458, 46.937}, {10.416, 46.962},
+			{10.384, 46.993}, {10.373, 46.996}, {10.339, 46.984}, {10.314, 46.964}, {10.296, 46.941},
+			{10.296, 46.923}, {10.271, 46.922}, {10.251, 46.925}, {10.235, 46.923}, {10.22, 46.906},
+			{10.212, 46.877}, {10.201, 46.867}, {10.158, 46.852}, {10.132, 46.847}, {10.111, 46.847},
+			{10.068, 46.857}, {10.046, 46.866}, {10.007, 46.891}, {9.9, 46.914}, {9.875, 46.927},
+			{9.862, 46.94}, {9.861, 46.949}, {9.871, 46.999}, {9.856, 47.004}, {9.858, 47.015},
+			{9.669, 47.056}, {9.652, 47.058}, {9.6, 47.053}, {9.581, 47.057}, {9.609, 47.081},
+			{9.616, 47.107}, {9.606, 47.132}, {9.582, 47.155}, {9.552, 47.167}, {9.552, 47.176},
+			{9.562, 47.191}, {9.563, 47.198}, {9.54, 47.229}, {9.547, 47.243}, {9.53, 47.254},
+			{9.521, 47.263}, {9.553, 47.3}, {9.587, 47.328}, {9.601, 47.361}, {9.64, 47.395}, {9.65, 47.41},
+			{9.65, 47.452}, {9.622, 47.469}, {9.585, 47.481}, {9.555, 47.511}, {9.553, 47.517},
+			{9.547, 47.535}, {9.55, 47.534}, {9.613, 47.522}, {9.677, 47.523}, {9.704, 47.531},
+			{9.731, 47.565}, {9.753, 47.582}, {9.767, 47.587}, {9.782, 47.588}, {9.796, 47.585},
+			{9.808, 47.576}, {9.811, 47.564}, {9.809, 47.552}, {9.813, 47.542}, {9.833, 47.535},
+			{9.859, 47.541}, {9.889, 47.534}, {9.919, 47.532}, {9.934, 47.534}, {9.946, 47.541},
+			{9.949, 47.524}, {9.972, 47.506}, {9.981, 47.493}, {9.983, 47.481}, {9.994, 47.477},
+			{10.023, 47.488}, {10.072, 47.439}, {10.081, 47.427}, {10.076, 47.417}, {10.053, 47.405},
+			{10.064, 47.396}, {10.068, 47.375}, {10.073, 47.365}, {10.083, 47.359}, {10.11, 47.367},
+			{10.131, 47.363}, {10.147, 47.374}, {10.191, 47.379}, {10.209, 47.372}, {10.209, 47.354},
+			{10.19, 47.317}, {10.193, 47.305}, {10.191, 47.298}, {10.169, 47.29}, {10.155, 47.273},
+			{10.16, 47.271}, {10.239, 47.278}, {10.261, 47.283}, {10.306, 47.302}, {10.325, 47.314},
+			{10.343, 47.331}, {10.372, 47.367}, {10.412, 47.381}, {10.428, 47.396}, {10.443, 47.416},
+			{10.452, 47.439}, {10.452, 47.46}, {10.448, 47.473}, {10.434, 47.488}, {10.42, 47.493},
+			{10.431, 47.542}, {10.416, 47.564}, {10.414, 47.573}, {10.429, 47.577}, {10.454, 47.581},
+			{10.457, 47.579}, {10.462, 47.57}, {10.452, 47.555}, {10.454, 47.546}, {10.467, 47.538},
+			{10.483, 47.533}, {10.525, 47.528}, {10.537, 47.53}, {10.55, 47.537}, {10.57, 47.556},
+			{10.584, 47.562}, {10.608, 47.562}, {10.74, 47.528}, {10.752, 47.515}, {10.761, 47.514},
+			{10.79, 47.516}, {10.845, 47.531}, {10.859, 47.531}, {10.892, 47.515}, {10.884, 47.508},
+			{10.852, 47.493}, {10.858, 47.485}, {10.91, 47.469}, {10.96, 47.433}, {10.963, 47.421},
+			{10.955, 47.41}, {10.966, 47.396}, {10.979, 47.391}, {11.084, 47.39}, {11.103, 47.394},
+			{11.169, 47.424}, {11.194, 47.429}, {11.215, 47.423}, {11.213, 47.396}, {11.237, 47.394},
+			{11.259, 47.401}, {11.306, 47.435}, {11.324, 47.439}, {11.368, 47.44}, {11.384, 47.445},
+			{11.392, 47.455}, {11.389, 47.462}, {11.365, 47.469}, {11.413, 47.506}, {11.435, 47.51},
+			{11.483, 47.503}, {11.53, 47.508}, {11.551, 47.514}, {11.569, 47.527}, {11.589, 47.57},
+			{11.62, 47.59}, {11.683, 47.583}, {11.764, 47.583}, {11.82, 47.575}, {11.831, 47.578},
+			{11.841, 47.595}, {11.852, 47.599}, {11.936, 47.611}, {12.174, 47.605}, {12.202, 47.63},
+			{12.207, 47.646}, {12.205, 47.672}, {12.202, 47.678}, {12.182, 47.692}, {12.177, 47.706},
+			{12.192, 47.71}, {12.225, 47.727}, {12.242, 47.732}, {12.233, 47.709}, {12.223, 47.696},
+			{12.229, 47.685}, {12.239, 47.679}, {12.272, 47.688}, {12.294, 47.69}, {12.351, 47.682},
+			{12.408, 47.694}, {12.424, 47.692}, {12.445, 47.656}, {12.483, 47.634}, {12.497, 47.629},
+			{12.538, 47.631}, {12.554, 47.636}, {12.598, 47.663}, {12.617, 47.669}, {12.653, 47.675},
+			{12.689, 47.675}, {12.745, 47.665}, {12.762, 47.667}, {12.752, 47.649}, {12.767, 47.636},
+			{12.793, 47.625}, {12.813, 47.612}, {12.786, 47.603}, {12.774, 47.58}, {12.779, 47.555},
+			{12.804, 47.541}, {12.828, 47.537}, {12.883, 47.499}, {12.932, 47.474}, {12.943, 47.47},
+			{12.97, 47.476}, {12.991, 47.466}, {13.002, 47.466}, {13.037, 47.493}, {13.037, 47.501},
+			{13.029, 47.518}, {13.028, 47.542}, {13.041, 47.562}, {13.039, 47.561}, {13.038, 47.584},
+			{13.041, 47.583}, {13.057, 47.598}, {13.072, 47.622}, {13.075, 47.647}, {13.072, 47.659},
+			{13.064, 47.674}, {13.045, 47.697}, {13.02, 47.713}, {13.004, 47.715}, {12.964, 47.705},
+			{12.908, 47.712}, {12.892, 47.724}, {12.917, 47.75}, {12.921, 47.77}, {12.927, 47.777},
+			{12.991, 47.847}, {12.965, 47.872}, {12.931, 47.925}, {12.886, 47.953}, {12.862, 47.963},
+			{12.854, 47.97}, {12.846, 47.993}, {12.831, 48.015}, {12.76, 48.065}, {12.742, 48.087},
+			{12.737, 48.1}, {12.739, 48.113}, {12.745, 48.121}, {12.764, 48.124}, {12.782, 48.142},
+			{12.822, 48.161}, {12.863, 48.197}, {12.878, 48.202}, {12.933, 48.209}, {12.932, 48.212},
+			{12.979, 48.232}, {13.033, 48.264}, {13.136, 48.291}, {13.274, 48.307}, {13.308, 48.32},
+			{13.406, 48.377}, {13.42, 48.392}, {13.427, 48.419}, {13.436, 48.431}, {13.438, 48.479},
+			{13.456, 48.507}, {13.459, 48.516}, {13.457, 48.525}, {13.448, 48.535}, {13.44, 48.561},
+			{13.455, 48.573}, {13.487, 48.582}, {13.521, 48.585}, {13.624, 48.565}, {13.658, 48.551},
+			{13.673, 48.535}, {13.717, 48.522}, {13.725, 48.548}, {13.734, 48.56}, {13.758, 48.561},
+			{13.774, 48.569}, {13.796, 48.599}, {13.802, 48.612}, {13.806, 48.643}, {13.801, 48.675},
+			{13.804, 48.687}, {13.817, 48.696}, {13.799, 48.703}, {13.789, 48.717}, {13.784, 48.715},
+			{13.786, 48.725}, {13.816, 48.766}, {13.856, 48.759}, {13.875, 48.752}, {13.915, 48.731},
+			{13.982, 48.706}, {13.991, 48.7}, {14.014, 48.675}, {14.032, 48.667}, {14.035, 48.661},
+			{14.033, 48.655}, {14.02, 48.653}, {14.014, 48.644}, {14.008, 48.642}, {14.006, 48.64},
+			{14.011, 48.626}, {14.041, 48.601}, {14.075, 48.592}, {14.217, 48.581}, {14.316, 48.558},
+			{14.325, 48.559}, {14.353, 48.571}, {14.405, 48.586}, {14.421, 48.597}, {14.444, 48.637},
+			{14.458, 48.643}, {14.482, 48.624}, {14.534, 48.609}, {14.579, 48.62}, {14.594, 48.621},
+			{14.601, 48.618}, {14.602, 48.605}, {14.605, 48.6}, {14.635, 48.602}, {14.659, 48.577},
+			{14.676, 48.576}, {14.696, 48.59}, {14.701, 48.616}, {14.7, 48.646}, {14.704, 48.673},
+			{14.723, 48.693}, {14.775, 48.724}, {14.78, 48.743}, {14.79, 48.755}, {14.8, 48.777},
+			{14.815, 48.78}, {14.868, 48.776}, {14.919, 48.762}, {14.94, 48.763}, {14.951, 48.78},
+			{14.938, 48.803}, {14.968, 48.885}, {14.965, 48.972}, {14.978, 48.993}, {14.979, 49.006},
+			{15.004, 49.01}, {15.06, 48.997}, {15.137, 48.993}, {15.149, 48.965}, {15.149, 48.952},
+			{15.142, 48.937}, {15.162, 48.937}, {15.238, 48.951}, {15.257, 48.964}, {15.263, 48.983},
+			{15.267, 48.987}, {15.275, 48.987}, {15.289, 48.975}, {15.336, 48.975}, {15.358, 48.971},
+			{15.406, 48.955}, {15.45, 48.945}, {15.472, 48.937}, {15.521, 48.908}, {15.604, 48.887},
+			{15.681, 48.858}, {15.727, 48.855}, {15.779, 48.871}, {15.818, 48.872}, {15.824, 48.869},
+			{15.828, 48.857}, {15.833, 48.852}, {15.859, 48.849}, {15.878, 48.842}, {15.886, 48.842},
+			{15.876, 48.833}, {15.9, 48.835}, {15.907, 48.83}, {15.908, 48.82}, {15.925, 48.823},
+			{15.93, 48.818}, {15.932, 48.806}, {16.032, 48.758}, {16.085, 48.743}, {16.178, 48.747},
+			{16.318, 48.733}, {16.339, 48.736}, {16.358, 48.727}, {16.374, 48.73}, {16.385, 48.737},
+			{16.436, 48.795}, {16.453, 48.802}, {16.492, 48.8}, {16.52, 48.806}, {16.546, 48.796},
+			{16.643, 48.778}, {16.662, 48.74}, {16.675, 48.734}, {16.729, 48.733}, {16.799, 48.709},
+			{16.857, 48.72}, {16.873, 48.719}, {16.897, 48.697}, {16.911, 48.631},
+		},
+	},
+})
+
+// france is metropolitan France with Corsica.
+var france = newBoundary(MultiPolygon{
+	{
+		{
+			{2.522, 51.088}, {2.537, 51.065}, {2.547, 51.02}, {2.557, 51.001}, {2.593, 50.976},
+			{2.608, 50.961}, {2.612, 50.941}, {2.583, 50.921}, {2.577, 50.912}, {2.597, 50.868},
+			{2.587, 50.845}, {2.607, 50.835}, {2.62, 50.816}, {2.642, 50.812}, {2.679, 50.813},
+			{2.692, 50.809}, {2.707, 50.789}, {2.744, 50.766}, {2.768, 50.733}, {2.787, 50.723},
+			{2.887, 50.697}, {2.9, 50.703}, {2.93, 50.736}, {2.948, 50.749}, {2.971, 50.758},
+			{3.103, 50.784}, {3.129, 50.779}, {3.146, 50.769}, {3.177, 50.734}, {3.189, 50.715},
+			{3.197, 50.709}, {3.224, 50.705}, {3.232, 50.696}, {3.244, 50.672}, {3.23, 50.655},
+			{3.238, 50.626}, {3.264, 50.57}, {3.266, 50.538}, {3.271, 50.527}, {3.3, 50.507}, {3.361, 50.49},
+			{3.385, 50.492}, {3.429, 50.502}, {3.463, 50.519}, {3.477, 50.52}, {3.491, 50.517},
+			{3.498, 50.512}, {3.496, 50.504}, {3.487, 50.493}, {3.499, 50.487}, {3.564, 50.487},
+			{3.608, 50.477}, {3.628, 50.464}, {3.644, 50.446}, {3.653, 50.407}, {3.653, 50.36},
+			{3.662, 50.319}, {3.697, 50.298}, {3.701, 50.305}, {3.708, 50.306}, {3.733, 50.337},
+			{3.742, 50.343}, {3.755, 50.346}, {3.799, 50.348}, {3.84, 50.345}, {3.896, 50.325},
+			{3.919, 50.325}, {3.982, 50.343}, {4.003, 50.344}, {4.023, 50.338}, {4.056, 50.315},
+			{4.098, 50.295}, {4.125, 50.257}, {4.144, 50.252}, {4.151, 50.256}, {4.15, 50.27},
+			{4.163, 50.273}, {4.198, 50.258}, {4.204, 50.252}, {4.201, 50.242}, {4.168, 50.222},
+			{4.153, 50.205}, {4.147, 50.186}, {4.128, 50.146}, {4.126, 50.128}, {4.14, 50.124},
+			{4.171, 50.13}, {4.181, 50.127}, {4.197, 50.084}, {4.21, 50.067}, {4.21, 50.06}, {4.163, 50.042},
+			{4.137, 50.025}, {4.128, 50.006}, {4.133, 50.004}, {4.14, 49.975}, {4.132, 49.975},
+			{4.201, 49.953}, {4.278, 49.96}, {4.435, 49.932}, {4.465, 49.936}, {4.604, 49.98},
+			{4.646, 49.984}, {4.657, 49.989}, {4.666, 50.001}, {4.673, 50.016}, {4.681, 50.048},
+			{4.673, 50.066}, {4.682, 50.084}, {4.789, 50.153}, {4.816, 50.161}, {4.82, 50.146},
+			{4.831, 50.143}, {4.863, 50.148}, {4.872, 50.14}, {4.871, 50.122}, {4.863, 50.084},
+			{4.852, 50.092}, {4.846, 50.091}, {4.827, 50.064}, {4.827, 50.036}, {4.788, 49.974},
+			{4.784, 49.958}, {4.797, 49.944}, {4.845, 49.932}, {4.86, 49.913}, {4.862, 49.9},
+			{4.859, 49.892}, {4.849, 49.871}, {4.837, 49.859}, {4.835, 49.853}, {4.849, 49.832},
+			{4.849, 49.794}, {4.858, 49.787}, {4.884, 49.784}, {4.95, 49.796}, {4.971, 49.797},
+			{4.989, 49.791}, {5.026, 49.767}, {5.046, 49.759}, {5.082, 49.753}, {5.094, 49.745},
+			{5.136, 49.705}, {5.169, 49.687}, {5.192, 49.683}, {5.238, 49.691}, {5.259, 49.691},
+			{5.299, 49.662}, {5.306, 49.648}, {5.294, 49.641}, {5.291, 49.626}, {5.293, 49.617},
+			{5.298, 49.613}, {5.322, 49.613}, {5.335, 49.626}, {5.383, 49.613}, {5.402, 49.602},
+			{5.45, 49.546}, {5.454, 49.537}, {5.45, 49.508}, {5.456, 49.499}, {5.478, 49.495},
+			{5.548, 49.523}, {5.579, 49.514}, {5.602, 49.514}, {5.608, 49.525}, {5.62, 49.536},
+			{5.645, 49.544}, {5.71, 49.531}, {5.723, 49.535}, {5.736, 49.546}, {5.746, 49.549},
+			{5.757, 49.548}, {5.779, 49.54}, {5.791, 49.538}, {5.822, 49.511}, {5.84, 49.5}, {5.865, 49.492},
+			{5.928, 49.482}, {5.947, 49.47}, {5.961, 49.441}, {5.978, 49.445}, {6.063, 49.448},
+			{6.078, 49.452}, {6.088, 49.462}, {6.1, 49.465}, {6.102, 49.476}, {6.107, 49.483},
+			{6.143, 49.486}, {6.136, 49.495}, {6.194, 49.499}, {6.222, 49.497}, {6.249, 49.49},
+			{6.299, 49.466}, {6.325, 49.457}, {6.345, 49.455}, {6.354, 49.455}, {6.392, 49.467},
+			{6.403, 49.465}, {6.495, 49.436}, {6.512, 49.425}, {6.521, 49.408}, {6.519, 49.402},
+			{6.574, 49.362}, {6.578, 49.356}, {6.573, 49.348}, {6.556, 49.347}, {6.55, 49.338},
+			{6.556, 49.326}, {6.64, 49.271}, {6.64, 49.253}, {6.664, 49.244}, {6.681, 49.207},
+			{6.703, 49.214}, {6.709, 49.198}, {6.701, 49.173}, {6.714, 49.159}, {6.726, 49.156},
+			{6.77, 49.155}, {6.809, 49.146}, {6.822, 49.148}, {6.839, 49.163}, {6.828, 49.196},
+			{6.833, 49.209}, {6.843, 49.211}, {6.885, 49.205}, {6.914, 49.207}, {6.925, 49.206},
+			{6.988, 49.182}, {7.009, 49.182}, {7.009, 49.146}, {7.021, 49.119}, {7.043, 49.108},
+			{7.073, 49.12}, {7.075, 49.125}, {7.071, 49.136}, {7.08, 49.142}, {7.124, 49.123},
+			{7.155, 49.114}, {7.241, 49.114}, {7.274, 49.105}, {7.297, 49.128}, {7.334, 49.134},
+			{7.351, 49.159}, {7.391, 49.17}, {7.41, 49.169}, {7.415, 49.158}, {7.458, 49.156},
+			{7.476, 49.152}, {7.483, 49.137}, {7.471, 49.128}, {7.485, 49.119}, {7.521, 49.084},
+			{7.543, 49.075}, {7.586, 49.07}, {7.609, 49.062}, {7.626, 49.043}, {7.635, 49.038},
+			{7.649, 49.036}, {7.685, 49.043}, {7.727, 49.036}, {7.774, 49.048}, {7.857, 49.032},
+			{7.932, 49.035}, {8.09, 48.979}, {8.189, 48.966}, {8.2, 48.959}, {8.179, 48.943},
+			{8.148, 48.904}, {8.103, 48.821}, {8.09, 48.808}, {8.048, 48.791}, {8.039, 48.791},
+			{8.027, 48.78}, {8.023, 48.765}, {8.017, 48.762}, {7.97, 48.757}, {7.965, 48.748},
+			{7.964, 48.729}, {7.959, 48.721}, {7.896, 48.677}, {7.881, 48.668}, {7.852, 48.659},
+			{7.81, 48.615}, {7.802, 48.582}, {7.802, 48.548}, {7.813, 48.526}, {7.813, 48.519},
+			{7.776, 48.498}, {7.771, 48.489}, {7.769, 48.47}, {7.751, 48.444}, {7.738, 48.407},
+			{7.737, 48.393}, {7.74, 48.381}, {7.752, 48.355}, {7.751, 48.341}, {7.737, 48.327},
+			{7.702, 48.311}, {7.687, 48.253}, {7.677, 48.238}, {7.613, 48.179}, {7.607, 48.167},
+			{7.605, 48.153}, {7.586, 48.13}, {7.573, 48.095}, {7.576, 48.054}, {7.619, 48.003},
+			{7.621, 47.971}, {7.612, 47.959}, {7.585, 47.94}, {7.58, 47.927}, {7.579, 47.906},
+			{7.559, 47.883}, {7.559, 47.869}, {7.564, 47.85}, {7.562, 47.839}, {7.543, 47.829},
+			{7.529, 47.797}, {7.526, 47.783}, {7.538, 47.748}, {7.538, 47.732}, {7.512, 47.707},
+			{7.518, 47.675}, {7.522, 47.67}, {7.537, 47.665}, {7.55, 47.652}, {7.573, 47.622},
+			{7.59, 47.608}, {7.592, 47.598}, {7.59, 47.595}, {7.586, 47.585}, {7.585, 47.584},
+			{7.526, 47.566}, {7.483, 47.542}, {7.505, 47.533}, {7.505, 47.523}, {7.501, 47.517},
+			{7.477, 47.515}, {7.478, 47.508}, {7.486, 47.498}, {7.484, 47.493}, {7.467, 47.482},
+			{7.426, 47.493}, {7.414, 47.49}, {7.414, 47.484}, {7.428, 47.471}, {7.429, 47.465},
+			{7.426, 47.456}, {7.406, 47.438}, {7.379, 47.431}, {7.309, 47.433}, {7.283, 47.429},
+			{7.238, 47.417}, {7.23, 47.419}, {7.219, 47.428}, {7.19, 47.435}, {7.168, 47.444},
+			{7.163, 47.46}, {7.181, 47.488}, {7.154, 47.486}, {7.104, 47.496}, {7.054, 47.49},
+			{7.028, 47.493}, {7.01, 47.499}, {6.973, 47.489}, {6.976, 47.478}, {6.991, 47.452},
+			{6.983, 47.444}, {6.969, 47.435}, {6.952, 47.429}, {6.926, 47.425}, {6.925, 47.406},
+			{6.899, 47.396}, {6.884, 47.383}, {6.872, 47.367}, {6.867, 47.354}, {6.986, 47.362},
+			{7.004, 47.368}, {7.034, 47.351}, {7.044, 47.34}, {7.037, 47.33}, {7.006, 47.319},
+			{6.992, 47.306}, {6.977, 47.304}, {6.959, 47.291}, {6.952, 47.27}, {6.956, 47.245},
+			{6.888, 47.211}, {6.859, 47.191}, {6.84, 47.17}, {6.775, 47.128}, {6.745, 47.121},
+			{6.746, 47.104}, {6.728, 47.097}, {6.724, 47.091}, {6.699, 47.085}, {6.69, 47.078},
+			{6.676, 47.062}, {6.688, 47.044}, {6.665, 47.021}, {6.599, 46.987}, {6.491, 46.963},
+			{6.443, 46.944}, {6.428, 46.909}, {6.445, 46.883}, {6.448, 46.872}, {6.447, 46.858},
+			{6.434, 46.84}, {6.417, 46.802}, {6.433, 46.786}, {6.433, 46.769}, {6.429, 46.761},
+			{6.407, 46.746}, {6.374, 46.734}, {6.338, 46.707}, {6.266, 46.68}, {6.132, 46.596},
+			{6.118, 46.583}, {6.122, 46.57}, {6.146, 46.552}, {6.11, 46.521}, {6.076, 46.48},
+			{6.064, 46.471}, {6.06, 46.46}, {6.065, 46.451}, {6.068, 46.434}, {6.066, 46.427},
+			{6.054, 46.419}, {6.108, 46.396}, {6.123, 46.386}, {6.135, 46.37}, {6.136, 46.359},
+			{6.101, 46.301}, {6.094, 46.273}, {6.094, 46.253}, {6.09, 46.246}, {6.062, 46.241},
+			{6.043, 46.243}, {5.959, 46.212}, {5.955, 46.2}, {5.965, 46.186}, {5.983, 46.171},
+			{5.959, 46.13}, {5.983, 46.14}, {6.028, 46.148}, {6.074, 46.149}, {6.108, 46.139}, {6.14, 46.15},
+			{6.191, 46.192}, {6.255, 46.221}, {6.281, 46.24}, {6.276, 46.263}, {6.269, 46.265},
+			{6.252, 46.26}, {6.238, 46.268}, {6.214, 46.315}, {6.219, 46.329}, {6.269, 46.375},
+			{6.302, 46.394}, {6.332, 46.401}, {6.365, 46.402}, {6.398, 46.408}, {6.483, 46.449},
+			{6.547, 46.457}, {6.614, 46.456}, {6.763, 46.429}, {6.778, 46.424}, {6.787, 46.414},
+			{6.789, 46.395}, {6.782, 46.378}, {6.756, 46.357}, {6.75, 46.346}, {6.769, 46.323},
+			{6.805, 46.297}, {6.828, 46.269}, {6.792, 46.222}, {6.775, 46.186}, {6.766, 46.152},
+			{6.774, 46.135}, {6.854, 46.123}, {6.869, 46.112}, {6.868, 46.105}, {6.849, 46.085},
+			{6.851, 46.086}, {6.853, 46.076}, {6.851, 46.05}, {6.869, 46.044}, {6.892, 46.056},
+			{6.915, 46.049}, {6.988, 45.993}, {7.015, 45.933}, {7.022, 45.925}, {7.008, 45.921},
+			{6.997, 45.911}, {6.983, 45.886}, {6.969, 45.87}, {6.949, 45.858}, {6.905, 45.845},
+			{6.873, 45.845}, {6.871, 45.828}, {6.864, 45.827}, {6.859, 45.833}, {6.843, 45.839},
+			{6.801, 45.826}, {6.788, 45.812}, {6.783, 45.795}, {6.785, 45.76}, {6.796, 45.718},
+			{6.816, 45.697}, {6.843, 45.683}, {6.883, 45.676}, {6.919, 45.653}, {6.951, 45.647},
+			{6.963, 45.641}, {6.969, 45.626}, {6.953, 45.594}, {6.955, 45.586}, {6.97, 45.567},
+			{6.975, 45.526}, {6.983, 45.511}, {7.028, 45.493}, {7.072, 45.47}, {7.097, 45.435},
+			{7.161, 45.411}, {7.16, 45.398}, {7.151, 45.383}, {7.13, 45.357}, {7.093, 45.324},
+			{7.098, 45.295}, {7.108, 45.275}, {7.108, 45.259}, {7.081, 45.243}, {7.055, 45.214},
+			{7.03, 45.228}, {6.994, 45.221}, {6.959, 45.21}, {6.946, 45.201}, {6.928, 45.18}, {6.914, 45.17},
+			{6.873, 45.166}, {6.868, 45.157}, {6.879, 45.147}, {6.877, 45.141}, {6.844, 45.13},
+			{6.808, 45.139}, {6.771, 45.153}, {6.736, 45.157}, {6.702, 45.141}, {6.667, 45.14},
+			{6.633, 45.126}, {6.615, 45.115}, {6.603, 45.103}, {6.62, 45.084}, {6.637, 45.074},
+			{6.64, 45.05}, {6.652, 45.036}, {6.662, 45.029}, {6.697, 45.027}, {6.723, 45.013},
+			{6.73, 44.985}, {6.728, 44.929}, {6.745, 44.908}, {6.778, 44.888}, {6.847, 44.859},
+			{6.866, 44.856}, {6.915, 44.863}, {6.933, 44.862}, {6.983, 44.847}, {7.005, 44.828},
+			{7.006, 44.812}, {6.998, 44.794}, {7.01, 44.772}, {7.019, 44.739}, {7.049, 44.698},
+			{7.055, 44.685}, {7.037, 44.685}, {7.001, 44.692}, {6.983, 44.692}, {6.96, 44.683},
+			{6.941, 44.667}, {6.934, 44.647}, {6.946, 44.625}, {6.932, 44.618}, {6.897, 44.575},
+			{6.846, 44.547}, {6.836, 44.534}, {6.839, 44.503}, {6.861, 44.475}, {6.918, 44.436},
+			{6.884, 44.423}, {6.877, 44.414}, {6.866, 44.377}, {6.87, 44.363}, {6.896, 44.34},
+			{6.921, 44.302}, {6.959, 44.277}, {6.973, 44.249}, {6.983, 44.242}, {6.996, 44.238},
+			{7.033, 44.243}, {7.046, 44.24}, {7.105, 44.218}, {7.145, 44.207}, {7.251, 44.16},
+			{7.309, 44.147}, {7.322, 44.132}, {7.331, 44.125}, {7.341, 44.124}, {7.381, 44.123},
+			{7.555, 44.159}, {7.584, 44.161}, {7.625, 44.18}, {7.656, 44.176}, {7.642, 44.144},
+			{7.654, 44.125}, {7.676, 44.109}, {7.692, 44.085}, {7.69, 44.067}, {7.653, 44.04},
+			{7.631, 43.994}, {7.609, 43.976}, {7.557, 43.944}, {7.537, 43.921}, {7.493, 43.886},
+			{7.478, 43.866}, {7.483, 43.84}, {7.502, 43.792}, {7.489, 43.785}, {7.446, 43.75},
+			{7.437, 43.743}, {7.426, 43.755}, {7.407, 43.764}, {7.388, 43.758}, {7.373, 43.746},
+			{7.366, 43.723}, {7.353, 43.716}, {7.331, 43.686}, {7.242, 43.685}, {7.204, 43.644},
+			{7.197, 43.657}, {7.152, 43.655}, {7.126, 43.606}, {7.139, 43.554}, {7.126, 43.548},
+			{7.088, 43.569}, {7.037, 43.536}, {7.031, 43.548}, {6.962, 43.54}, {6.941, 43.512},
+			{6.954, 43.5}, {6.894, 43.428}, {6.863, 43.439}, {6.847, 43.413}, {6.783, 43.409},
+			{6.757, 43.423}, {6.731, 43.408}, {6.718, 43.351}, {6.675, 43.346}, {6.591, 43.269},
+			{6.693, 43.273}, {6.668, 43.24}, {6.68, 43.202}, {6.66, 43.19}, {6.61, 43.173}, {6.593, 43.188},
+			{6.54, 43.182}, {6.506, 43.151}, {6.402, 43.147}, {6.366, 43.119}, {6.375, 43.091},
+			{6.28, 43.119}, {6.193, 43.109}, {6.153, 43.072}, {6.153, 43.048}, {6.185, 43.045},
+			{6.154, 43.034}, {6.089, 43.035}, {6.132, 43.053}, {6.129, 43.071}, {6.11, 43.084},
+			{6.027, 43.078}, {6.017, 43.099}, {5.934, 43.114}, {5.941, 43.134}, {5.879, 43.12},
+			{5.951, 43.08}, {5.875, 43.069}, {5.857, 43.045}, {5.782, 43.079}, {5.818, 43.099},
+			{5.811, 43.107}, {5.776, 43.131}, {5.696, 43.147}, {5.674, 43.18}, {5.634, 43.187},
+			{5.612, 43.16}, {5.573, 43.169}, {5.525, 43.213}, {5.347, 43.216}, {5.369, 43.27},
+			{5.339, 43.334}, {5.294, 43.355}, {5.238, 43.328}, {5.034, 43.335}, {5.006, 43.382},
+			{5.026, 43.409}, {5.174, 43.428}, {5.226, 43.48}, {5.223, 43.504}, {5.14, 43.486},
+			{5.106, 43.533}, {5.054, 43.538}, {5.029, 43.559}, {5.009, 43.518}, {5.062, 43.452},
+			{5.051, 43.429}, {4.998, 43.415}, {4.928, 43.44}, {4.868, 43.421}, {4.85, 43.401},
+			{4.868, 43.365}, {4.901, 43.367}, {4.856, 43.342}, {4.833, 43.382}, {4.758, 43.422},
+			{4.742, 43.449}, {4.742, 43.524}, {4.695, 43.579}, {4.729, 43.528}, {4.743, 43.423},
+			{4.823, 43.367}, {4.82, 43.346}, {4.592, 43.36}, {4.566, 43.371}, {4.586, 43.404}, {4.58, 43.43},
+			{4.513, 43.456}, {4.409, 43.448}, {4.182, 43.464}, {4.133, 43.487}, {4.117, 43.51},
+			{4.133, 43.533}, {4.105, 43.552}, {3.953, 43.54}, {3.74, 43.413}, {3.622, 43.375},
+			{3.513, 43.281}, {3.385, 43.284}, {3.318, 43.263}, {3.244, 43.219}, {3.114, 43.106},
+			{3.039, 42.943}, {3.056, 42.907}, {3.042, 42.629}, {3.052, 42.555}, {3.137, 42.525},
+			{3.182, 42.435}, {3.181, 42.431}, {3.138, 42.426}, {3.086, 42.427}, {3.054, 42.438},
+			{3.044, 42.445}, {3.03, 42.466}, {2.983, 42.469}, {2.966, 42.461}, {2.934, 42.47},
+			{2.901, 42.451}, {2.851, 42.448}, {2.789, 42.412}, {2.768, 42.41}, {2.729, 42.414},
+			{2.708, 42.413}, {2.69, 42.406}, {2.648, 42.381}, {2.64, 42.373}, {2.649, 42.354},
+			{2.662, 42.343}, {2.662, 42.339}, {2.635, 42.338}, {2.567, 42.353}, {2.548, 42.352},
+			{2.54, 42.347}, {2.532, 42.331}, {2.525, 42.326}, {2.515, 42.326}, {2.465, 42.341},
+			{2.446, 42.352}, {2.43, 42.368}, {2.423, 42.384}, {2.375, 42.389}, {2.317, 42.41},
+			{2.277, 42.429}, {2.246, 42.426}, {2.184, 42.412}, {2.137, 42.416}, {2.121, 42.411},
+			{2.104, 42.385}, {2.063, 42.358}, {2.052, 42.352}, {2.009, 42.347}, {1.996, 42.349},
+			{1.964, 42.367}, {1.954, 42.383}, {1.941, 42.42}, {1.927, 42.437}, {1.912, 42.442},
+			{1.87, 42.447}, {1.814, 42.475}, {1.793, 42.482}, {1.731, 42.488}, {1.712, 42.494},
+			{1.71, 42.501}, {1.707, 42.503}, {1.711, 42.528}, {1.722, 42.549}, {1.74, 42.562},
+			{1.765, 42.563}, {1.753, 42.577}, {1.729, 42.582}, {1.713, 42.59}, {1.722, 42.61},
+			{1.608, 42.618}, {1.543, 42.649}, {1.528, 42.649}, {1.498, 42.64}, {1.467, 42.641},
+			{1.451, 42.602}, {1.429, 42.595}, {1.415, 42.609}, {1.398, 42.644}, {1.361, 42.687},
+			{1.343, 42.69}, {1.338, 42.694}, {1.343, 42.709}, {1.319, 42.713}, {1.196, 42.714},
+			{1.163, 42.706}, {1.151, 42.707}, {1.134, 42.716}, {1.107, 42.76}, {1.089, 42.771},
+			{1.068, 42.776}, {0.983, 42.78}, {0.95, 42.796}, {0.921, 42.784}, {0.91, 42.788},
+			{0.886, 42.804}, {0.856, 42.813}, {0.813, 42.832}, {0.776, 42.832}, {0.724, 42.845},
+			{0.679, 42.845}, {0.656, 42.838}, {0.652, 42.824}, {0.654, 42.803}, {0.64, 42.775},
+			{0.651, 42.763}, {0.643, 42.759}, {0.633, 42.745}, {0.648, 42.741}, {0.66, 42.73},
+			{0.666, 42.715}, {0.665, 42.699}, {0.657, 42.688}, {0.644, 42.684}, {0.608, 42.688},
+			{0.484, 42.685}, {0.467, 42.69}, {0.409, 42.682}, {0.389, 42.685}, {0.384, 42.689},
+			{0.382, 42.7}, {0.354, 42.717}, {0.336, 42.713}, {0.318, 42.7}, {0.29, 42.67}, {0.275, 42.669},
+			{0.26, 42.682}, {0.238, 42.71}, {0.222, 42.716}, {0.169, 42.726}, {0.153, 42.725},
+			{0.134, 42.715}, {0.073, 42.708}, {-0.004, 42.688}, {-0.039, 42.685}, {-0.078, 42.697},
+			{-0.096, 42.711}, {-0.119, 42.717}, {-0.123, 42.73}, {-0.146, 42.746}, {-0.158, 42.761},
+			{-0.164, 42.778}, {-0.161, 42.795}, {-0.208, 42.784}, {-0.235, 42.786}, {-0.247, 42.805},
+			{-0.307, 42.832}, {-0.323, 42.843}, {-0.335, 42.825}, {-0.343, 42.823}, {-0.352, 42.829},
+			{-0.4, 42.801}, {-0.424, 42.791}, {-0.454, 42.789}, {-0.508, 42.807}, {-0.534, 42.808},
+			{-0.536, 42.784}, {-0.569, 42.773}, {-0.579, 42.777}, {-0.577, 42.79}, {-0.583, 42.796},
+			{-0.61, 42.799}, {-0.609, 42.811}, {-0.648, 42.85}, {-0.7, 42.876}, {-0.73, 42.878},
+			{-0.74, 42.885}, {-0.746, 42.894}, {-0.741, 42.911}, {-0.76, 42.947}, {-0.786, 42.953},
+			{-0.864, 42.943}, {-0.943, 42.948}, {-0.981, 42.957}, {-1.016, 42.976}, {-1.028, 42.987},
+			{-1.08, 42.993}, {-1.112, 43.008}, {-1.15, 43.006}, {-1.183, 43.025}, {-1.217, 43.039},
+			{-1.275, 43.047}, {-1.294, 43.055}, {-1.311, 43.069}, {-1.315, 43.083}, {-1.307, 43.096},
+			{-1.286, 43.109}, {-1.309, 43.114}, {-1.331, 43.107}, {-1.348, 43.093}, {-1.355, 43.073},
+			{-1.358, 43.047}, {-1.366, 43.033}, {-1.383, 43.029}, {-1.411, 43.031}, {-1.435, 43.036},
+			{-1.457, 43.045}, {-1.475, 43.06}, {-1.488, 43.082}, {-1.426, 43.135}, {-1.411, 43.167},
+			{-1.4, 43.207}, {-1.404, 43.243}, {-1.432, 43.265}, {-1.466, 43.261}, {-1.508, 43.277},
+			{-1.539, 43.284}, {-1.559, 43.284}, {-1.574, 43.277}, {-1.588, 43.252}, {-1.598, 43.247},
+			{-1.622, 43.247}, {-1.639, 43.256}, {-1.648, 43.282}, {-1.645, 43.292}, {-1.655, 43.298},
+			{-1.683, 43.305}, {-1.747, 43.291}, {-1.752, 43.299}, {-1.756, 43.319}, {-1.768, 43.331},
+			{-1.797, 43.341}, {-1.794, 43.386}, {-1.791, 43.385}, {-1.776, 43.376}, {-1.661, 43.4},
+			{-1.597, 43.443}, {-1.477, 43.58}, {-1.346, 44.019}, {-1.282, 44.302}, {-1.247, 44.505},
+			{-1.257, 44.556}, {-1.187, 44.665}, {-1.1, 44.655}, {-1.045, 44.669}, {-1.065, 44.717},
+			{-1.172, 44.778}, {-1.234, 44.707}, {-1.26, 44.627}, {-1.199, 45.121}, {-1.158, 45.279},
+			{-1.14, 45.49}, {-1.1, 45.554}, {-1.059, 45.57}, {-1.062, 45.532}, {-1.04, 45.507},
+			{-0.915, 45.45}, {-0.766, 45.326}, {-0.716, 45.135}, {-0.682, 45.076}, {-0.569, 44.998},
+			{-0.548, 44.902}, {-0.535, 44.895}, {-0.548, 44.95}, {-0.539, 44.977}, {-0.596, 45.025},
+			{-0.494, 44.998}, {-0.519, 45.029}, {-0.602, 45.053}, {-0.656, 45.099}, {-0.72, 45.361},
+			{-0.795, 45.477}, {-1.084, 45.66}, {-1.149, 45.679}, {-1.194, 45.71}, {-1.247, 45.71},
+			{-1.23, 45.792}, {-1.164, 45.807}, {-1.057, 45.74}, {-0.987, 45.717}, {-1.125, 45.808},
+			{-1.152, 45.868}, {-1.073, 45.904}, {-1.07, 45.926}, {-1.09, 45.951}, {-1.069, 45.964},
+			{-1.111, 46.018}, {-1.073, 46.007}, {-1.049, 46.039}, {-1.084, 46.057}, {-1.097, 46.102},
+			{-1.129, 46.116}, {-1.117, 46.128}, {-1.211, 46.175}, {-1.185, 46.197}, {-1.18, 46.229},
+			{-1.111, 46.259}, {-1.111, 46.303}, {-1.132, 46.324}, {-1.177, 46.326}, {-1.214, 46.311},
+			{-1.213, 46.279}, {-1.24, 46.284}, {-1.277, 46.32}, {-1.37, 46.354}, {-1.449, 46.343},
+			{-1.481, 46.408}, {-1.626, 46.431}, {-1.617, 46.444}, {-1.633, 46.454}, {-1.761, 46.492},
+			{-1.789, 46.533}, {-1.782, 46.495}, {-1.795, 46.495}, {-1.823, 46.594}, {-1.91, 46.692},
+			{-1.956, 46.704}, {-2.05, 46.792}, {-2.119, 46.82}, {-2.129, 46.893}, {-2.096, 46.916},
+			{-2.102, 46.931}, {-2.049, 46.957}, {-1.991, 47.035}, {-2.03, 47.092}, {-2.241, 47.142},
+			{-2.165, 47.168}, {-2.161, 47.271}, {-2.015, 47.3}, {-1.917, 47.284}, {-1.898, 47.273},
+			{-1.919, 47.266}, {-1.804, 47.219}, {-1.727, 47.211}, {-1.788, 47.226}, {-1.906, 47.294},
+			{-2.01, 47.32}, {-2.148, 47.313}, {-2.278, 47.246}, {-2.316, 47.252}, {-2.371, 47.286},
+			{-2.448, 47.267}, {-2.542, 47.3}, {-2.476, 47.291}, {-2.436, 47.307}, {-2.481, 47.348},
+			{-2.502, 47.321}, {-2.515, 47.365}, {-2.556, 47.383}, {-2.477, 47.416}, {-2.439, 47.41},
+			{-2.439, 47.396}, {-2.392, 47.424}, {-2.448, 47.431}, {-2.439, 47.445}, {-2.489, 47.459},
+			{-2.481, 47.478}, {-2.495, 47.491}, {-2.364, 47.506}, {-2.517, 47.528}, {-2.622, 47.513},
+			{-2.632, 47.52}, {-2.577, 47.554}, {-2.638, 47.54}, {-2.618, 47.527}, {-2.666, 47.534},
+			{-2.681, 47.504}, {-2.83, 47.504}, {-2.909, 47.558}, {-2.865, 47.567}, {-2.851, 47.547},
+			{-2.816, 47.547}, {-2.81, 47.568}, {-2.741, 47.551}, {-2.689, 47.604}, {-2.684, 47.623},
+			{-2.707, 47.643}, {-2.696, 47.623}, {-2.714, 47.616}, {-2.7, 47.609}, {-2.709, 47.602},
+			{-2.775, 47.629}, {-2.734, 47.622}, {-2.746, 47.634}, {-2.789, 47.643}, {-2.775, 47.637},
+			{-2.919, 47.596}, {-2.913, 47.609}, {-2.94, 47.602}, {-2.933, 47.637}, {-2.975, 47.664},
+			{-2.964, 47.603}, {-2.933, 47.574}, {-2.94, 47.561}, {-2.994, 47.596}, {-2.989, 47.582},
+			{-3.003, 47.579}, {-3.012, 47.607}, {-3.016, 47.581}, {-3.074, 47.576}, {-3.123, 47.599},
+			{-3.123, 47.519}, {-3.085, 47.478}, {-3.132, 47.478}, {-3.145, 47.516}, {-3.132, 47.557},
+			{-3.141, 47.598}, {-3.201, 47.64}, {-3.186, 47.681}, {-3.111, 47.704}, {-3.118, 47.732},
+			{-3.139, 47.725}, {-3.139, 47.753}, {-3.193, 47.746}, {-3.159, 47.711}, {-3.207, 47.697},
+			{-3.193, 47.684}, {-3.224, 47.656}, {-3.262, 47.684}, {-3.358, 47.697}, {-3.283, 47.691},
+			{-3.358, 47.711}, {-3.283, 47.787}, {-3.383, 47.737}, {-3.364, 47.718}, {-3.446, 47.705},
+			{-3.528, 47.78}, {-3.625, 47.78}, {-3.706, 47.797}, {-3.723, 47.813}, {-3.775, 47.794},
+			{-3.852, 47.801}, {-3.886, 47.855}, {-3.962, 47.9}, {-3.988, 47.903}, {-3.979, 47.883},
+			{-4.009, 47.851}, {-4.029, 47.855}, {-4.002, 47.869}, {-4.037, 47.855}, {-4.107, 47.88},
+			{-4.132, 47.924}, {-4.143, 47.912}, {-4.111, 47.869}, {-4.153, 47.855}, {-4.186, 47.874},
+			{-4.18, 47.842}, {-4.153, 47.842}, {-4.185, 47.813}, {-4.372, 47.807}, {-4.372, 47.834},
+			{-4.348, 47.838}, {-4.347, 47.863}, {-4.372, 47.916}, {-4.435, 47.976}, {-4.533, 48.026},
+			{-4.572, 48.006}, {-4.602, 48.026}, {-4.728, 48.041}, {-4.701, 48.075}, {-4.285, 48.115},
+			{-4.276, 48.144}, {-4.302, 48.196}, {-4.366, 48.215}, {-4.379, 48.232}, {-4.485, 48.243},
+			{-4.532, 48.183}, {-4.557, 48.177}, {-4.544, 48.205}, {-4.564, 48.232}, {-4.547, 48.253},
+			{-4.626, 48.267}, {-4.626, 48.287}, {-4.575, 48.29}, {-4.547, 48.347}, {-4.53, 48.342},
+			{-4.543, 48.305}, {-4.516, 48.296}, {-4.427, 48.3}, {-4.389, 48.287}, {-4.263, 48.3},
+			{-4.29, 48.294}, {-4.269, 48.288}, {-4.187, 48.308}, {-4.325, 48.322}, {-4.269, 48.363},
+			{-4.454, 48.335}, {-4.397, 48.388}, {-4.29, 48.432}, {-4.599, 48.35}, {-4.691, 48.363},
+			{-4.704, 48.342}, {-4.768, 48.345}, {-4.783, 48.376}, {-4.765, 48.378}, {-4.766, 48.39},
+			{-4.785, 48.436}, {-4.768, 48.469}, {-4.749, 48.473}, {-4.775, 48.491}, {-4.765, 48.52},
+			{-4.722, 48.568}, {-4.701, 48.561}, {-4.708, 48.568}, {-4.692, 48.576}, {-4.642, 48.582},
+			{-4.577, 48.568}, {-4.608, 48.603}, {-4.53, 48.602}, {-4.557, 48.609}, {-4.564, 48.63},
+			{-4.407, 48.643}, {-4.427, 48.658}, {-4.343, 48.679}, {-4.299, 48.664}, {-4.307, 48.642},
+			{-4.242, 48.658}, {-4.194, 48.65}, {-4.213, 48.661}, {-4.174, 48.691}, {-4.079, 48.697},
+			{-4.064, 48.685}, {-4.057, 48.712}, {-3.974, 48.733}, {-3.955, 48.658}, {-3.892, 48.678},
+			{-3.906, 48.664}, {-3.852, 48.63}, {-3.858, 48.685}, {-3.837, 48.671}, {-3.837, 48.707},
+			{-3.817, 48.712}, {-3.81, 48.733}, {-3.585, 48.683}, {-3.571, 48.688}, {-3.58, 48.733},
+			{-3.528, 48.739}, {-3.582, 48.791}, {-3.543, 48.808}, {-3.515, 48.842}, {-3.398, 48.807},
+			{-3.26, 48.848}, {-3.23, 48.872}, {-3.207, 48.855}, {-3.228, 48.808}, {-3.221, 48.794},
+			{-3.145, 48.862}, {-3.091, 48.871}, {-3.091, 48.836}, {-3.077, 48.828}, {-3.125, 48.76},
+			{-3.066, 48.824}, {-3.008, 48.821}, {-3.042, 48.788}, {-2.933, 48.767}, {-2.947, 48.733},
+			{-2.827, 48.658}, {-2.813, 48.599}, {-2.714, 48.561}, {-2.734, 48.534}, {-2.682, 48.509},
+			{-2.676, 48.54}, {-2.62, 48.544}, {-2.569, 48.589}, {-2.474, 48.626}, {-2.46, 48.643},
+			{-2.487, 48.65}, {-2.386, 48.65}, {-2.316, 48.699}, {-2.282, 48.671}, {-2.33, 48.63},
+			{-2.313, 48.62}, {-2.251, 48.65}, {-2.214, 48.582}, {-2.193, 48.616}, {-2.174, 48.583},
+			{-2.159, 48.589}, {-2.172, 48.602}, {-2.161, 48.613}, {-2.124, 48.609}, {-2.145, 48.636},
+			{-2.052, 48.65}, {-2.001, 48.589}, {-2.008, 48.575}, {-1.976, 48.536}, {-1.98, 48.514},
+			{-1.939, 48.534}, {-1.967, 48.547}, {-1.98, 48.589}, {-1.953, 48.575}, {-2, 48.601},
+			{-2.029, 48.643}, {-1.986, 48.688}, {-1.946, 48.699}, {-1.844, 48.712}, {-1.836, 48.691},
+			{-1.861, 48.664}, {-1.861, 48.641}, {-1.796, 48.616}, {-1.644, 48.623}, {-1.58, 48.643},
+			{-1.357, 48.643}, {-1.395, 48.668}, {-1.443, 48.663}, {-1.456, 48.683}, {-1.503, 48.696},
+			{-1.516, 48.725}, {-1.554, 48.747}, {-1.567, 48.822}, {-1.603, 48.849}, {-1.579, 48.863},
+			{-1.564, 48.922}, {-1.543, 48.935}, {-1.563, 48.958}, {-1.546, 49.03}, {-1.508, 49.034},
+			{-1.55, 49.04}, {-1.579, 49.013}, {-1.594, 49.029}, {-1.589, 49.082}, {-1.608, 49.103},
+			{-1.569, 49.144}, {-1.589, 49.144}, {-1.61, 49.216}, {-1.548, 49.225}, {-1.617, 49.238},
+			{-1.625, 49.219}, {-1.672, 49.28}, {-1.658, 49.288}, {-1.699, 49.314}, {-1.707, 49.329},
+			{-1.686, 49.335}, {-1.699, 49.356}, {-1.715, 49.339}, {-1.776, 49.37}, {-1.768, 49.384},
+			{-1.816, 49.384}, {-1.819, 49.452}, {-1.885, 49.531}, {-1.844, 49.572}, {-1.84, 49.62},
+			{-1.869, 49.662}, {-1.946, 49.678}, {-1.935, 49.724}, {-1.839, 49.715}, {-1.816, 49.692},
+			{-1.617, 49.664}, {-1.607, 49.65}, {-1.511, 49.663}, {-1.456, 49.702}, {-1.409, 49.712},
+			{-1.263, 49.696}, {-1.228, 49.624}, {-1.264, 49.589}, {-1.301, 49.582}, {-1.306, 49.558},
+			{-1.262, 49.493}, {-1.176, 49.415}, {-1.182, 49.356}, {-1.152, 49.363}, {-1.123, 49.345},
+			{-1.103, 49.349}, {-1.106, 49.379}, {-1.072, 49.397}, {-0.94, 49.392}, {-0.822, 49.363},
+			{-0.411, 49.343}, {-0.219, 49.28}, {0.013, 49.335}, {0.162, 49.415}, {0.411, 49.452},
+			{0.493, 49.494}, {0.422, 49.464}, {0.34, 49.457}, {0.124, 49.486}, {0.079, 49.516},
+			{0.08, 49.542}, {0.186, 49.703}, {0.597, 49.857}, {0.675, 49.876}, {0.761, 49.875},
+			{1.221, 49.979}, {1.456, 50.124}, {1.487, 50.185}, {1.521, 50.215}, {1.673, 50.193},
+			{1.645, 50.212}, {1.665, 50.22}, {1.625, 50.224}, {1.542, 50.278}, {1.552, 50.349},
+			{1.572, 50.376}, {1.61, 50.371}, {1.555, 50.405}, {1.573, 50.445}, {1.579, 50.533},
+			{1.61, 50.548}, {1.578, 50.589}, {1.563, 50.678}, {1.606, 50.79}, {1.581, 50.869},
+			{1.653, 50.889}, {1.73, 50.944}, {1.921, 50.997}, {2.134, 51.02}, {2.316, 51.059},
+			{2.384, 51.055}, {2.521, 51.087},
+		},
+	},
+	{
+		{
+			{9.457, 42.647}, {9.482, 42.62}, {9.477, 42.62}, {9.477, 42.619},
+		},
+	},
+	{
+		{
+			{8.566, 42.209}, {8.574, 42.22}, {8.549, 42.242}, {8.688, 42.273}, {8.625, 42.319},
+			{8.605, 42.31}, {8.6, 42.322}, {8.635, 42.339}, {8.613, 42.352}, {8.552, 42.339},
+			{8.549, 42.377}, {8.599, 42.388}, {8.619, 42.42}, {8.66, 42.43}, {8.677, 42.472}, {8.657, 42.48},
+			{8.667, 42.51}, {8.717, 42.531}, {8.709, 42.579}, {8.723, 42.565}, {8.787, 42.562},
+			{8.807, 42.585}, {8.805, 42.606}, {9.05, 42.662}, {9.053, 42.682}, {9.118, 42.732},
+			{9.237, 42.723}, {9.292, 42.675}, {9.343, 42.749}, {9.335, 42.808}, {9.306, 42.839},
+			{9.333, 42.867}, {9.33, 42.904}, {9.36, 42.929}, {9.347, 43.004}, {9.422, 43.017},
+			{9.464, 42.99}, {9.454, 42.964}, {9.47, 42.942}, {9.483, 42.802}, {9.443, 42.641},
+			{9.477, 42.579}, {9.512, 42.565}, {9.49, 42.613}, {9.528, 42.564}, {9.546, 42.435},
+			{9.532, 42.374}, {9.56, 42.295}, {9.56, 42.147}, {9.546, 42.104}, {9.403, 41.953},
+			{9.402, 41.706}, {9.372, 41.684}, {9.378, 41.656}, {9.345, 41.64}, {9.347, 41.62},
+			{9.292, 41.626}, {9.279, 41.598}, {9.345, 41.604}, {9.351, 41.581}, {9.265, 41.531},
+			{9.279, 41.492}, {9.271, 41.469}, {9.211, 41.448}, {9.223, 41.427}, {9.211, 41.407},
+			{9.25, 41.428}, {9.223, 41.373}, {9.207, 41.366}, {9.096, 41.404}, {9.121, 41.435},
+			{9.067, 41.462}, {9.08, 41.482}, {9.039, 41.462}, {9.039, 41.476}, {8.936, 41.489},
+			{8.84, 41.531}, {8.853, 41.544}, {8.792, 41.561}, {8.796, 41.581}, {8.778, 41.592},
+			{8.802, 41.639}, {8.873, 41.651}, {8.923, 41.695}, {8.789, 41.713}, {8.776, 41.722},
+			{8.785, 41.742}, {8.696, 41.729}, {8.703, 41.742}, {8.655, 41.75}, {8.718, 41.772},
+			{8.709, 41.805}, {8.785, 41.824}, {8.751, 41.846}, {8.792, 41.859}, {8.778, 41.873},
+			{8.805, 41.907}, {8.764, 41.934}, {8.614, 41.901}, {8.614, 41.944}, {8.586, 41.969},
+			{8.655, 41.979}, {8.665, 42.014}, {8.743, 42.044}, {8.745, 42.057}, {8.699, 42.109},
+			{8.661, 42.105}, {8.56, 42.154}, {8.594, 42.167}, {8.566, 42.175}, {8.582, 42.195},
+		},
+	},
+	{
+		{
+			{-1.219, 45.944}, {-1.224, 45.935}, {-1.185, 45.916}, {-1.176, 45.899}, {-1.195, 45.83},
+			{-1.209, 45.814}, {-1.227, 45.82}, {-1.26, 45.882}, {-1.34, 45.939}, {-1.378, 45.976},
+			{-1.372, 45.995}, {-1.405, 46.053}, {-1.34, 46.038}, {-1.274, 45.998}, {-1.248, 46.002},
+			{-1.234, 45.992}, {-1.23, 45.958},
+		},
+	},
+	{
+		{
+			{-1.281, 46.156}, {-1.336, 46.167}, {-1.433, 46.211}, {-1.504, 46.208}, {-1.556, 46.252},
+			{-1.48, 46.259}, {-1.468, 46.25}, {-1.474, 46.238}, {-1.508, 46.246}, {-1.491, 46.224},
+			{-1.411, 46.238}, {-1.425, 46.224}, {-1.409, 46.218}, {-1.308, 46.207}, {-1.266, 46.181},
+			{-1.254, 46.162},
+		},
+	},
+	{
+		{
+			{-2.337, 46.732}, {-2.298, 46.72}, {-2.28, 46.71}, {-2.269, 46.697}, {-2.291, 46.704},
+			{-2.371, 46.704}, {-2.383, 46.723}, {-2.375, 46.732},
+		},
+	},
+	{
+		{
+			{-2.2, 46.985}, {-2.145, 46.957}, {-2.138, 46.923}, {-2.143, 46.91}, {-2.159, 46.916},
+			{-2.195, 46.962}, {-2.22, 46.974}, {-2.247, 46.964}, {-2.263, 46.98}, {-2.279, 47.018},
+			{-2.296, 47.033}, {-2.239, 47.035}, {-2.2, 47.024},
+		},
+	},
+	{
+		{
+			{-3.115, 47.331}, {-3.073, 47.328}, {-3.063, 47.321}, {-3.067, 47.302}, {-3.094, 47.297},
+			{-3.172, 47.302}, {-3.223, 47.312}, {-3.241, 47.321}, {-3.238, 47.336}, {-3.262, 47.383},
+			{-3.243, 47.395}, {-3.177, 47.371},
+		},
+	},
+	{
+		{
+			{-5.05, 48.479}, {-5.043, 48.472}, {-5.037, 48.473}, {-5.037, 48.465}, {-5.088, 48.447},
+			{-5.106, 48.445}, {-5.106, 48.451}, {-5.092, 48.451}, {-5.098, 48.461}, {-5.107, 48.463},
+			{-5.133, 48.458}, {-5.133, 48.465}, {-5.092, 48.481}, {-5.069, 48.485},
+		},
+	},
+	{
+		{
+			{6.235, 43.022}, {6.242, 43.026}, {6.251, 43.017}, {6.252, 43.003}, {6.216, 42.99},
+			{6.202, 42.987}, {6.166, 43}, {6.165, 43.005}, {6.175, 43.009}, {6.203, 43.008}, {6.215, 43.015},
+			{6.233, 43.016},
+		},
+	},
+})
+
+// netherlands is the European part of the Netherlands.
+var netherlands = newBoundary(MultiPolygon{
+	{
+		{
+			{7.195, 53.245}, {7.199, 53.201}, {7.195, 53.185}, {7.172, 53.138}, {7.173, 53.126},
+			{7.185, 53.105}, {7.194, 53.034}, {7.193, 52.998}, {7.184, 52.966}, {7.162, 52.933},
+			{7.08, 52.854}, {7.062, 52.824}, {7.053, 52.791}, {7.044, 52.683}, {7.037, 52.647},
+			{7.018, 52.626}, {6.968, 52.637}, {6.918, 52.632}, {6.865, 52.642}, {6.771, 52.641},
+			{6.737, 52.635}, {6.702, 52.619}, {6.71, 52.609}, {6.704, 52.591}, {6.704, 52.583},
+			{6.744, 52.56}, {6.688, 52.543}, {6.672, 52.542}, {6.684, 52.526}, {6.689, 52.491},
+			{6.695, 52.476}, {6.715, 52.462}, {6.741, 52.454}, {6.82, 52.447}, {6.872, 52.435},
+			{6.9, 52.432}, {6.951, 52.437}, {6.973, 52.451}, {7.034, 52.391}, {7.048, 52.365},
+			{7.04, 52.329}, {7.012, 52.285}, {7.012, 52.267}, {7.021, 52.245}, {7.029, 52.236},
+			{7.026, 52.231}, {6.982, 52.214}, {6.948, 52.183}, {6.928, 52.172}, {6.885, 52.16},
+			{6.865, 52.148}, {6.843, 52.12}, {6.831, 52.113}, {6.766, 52.108}, {6.75, 52.103},
+			{6.733, 52.092}, {6.711, 52.067}, {6.68, 52.06}, {6.673, 52.05}, {6.675, 52.035},
+			{6.778, 52.001}, {6.809, 51.98}, {6.811, 51.961}, {6.79, 51.951}, {6.767, 51.926},
+			{6.744, 51.908}, {6.717, 51.899}, {6.628, 51.898}, {6.529, 51.876}, {6.479, 51.853},
+			{6.463, 51.848}, {6.443, 51.848}, {6.378, 51.86}, {6.387, 51.838}, {6.382, 51.828},
+			{6.345, 51.821}, {6.342, 51.837}, {6.326, 51.843}, {6.287, 51.846}, {6.265, 51.866},
+			{6.225, 51.863}, {6.191, 51.871}, {6.159, 51.887}, {6.127, 51.897}, {6.093, 51.885},
+			{6.143, 51.855}, {6.156, 51.842}, {6.084, 51.854}, {6.043, 51.847}, {6.008, 51.833},
+			{5.989, 51.827}, {5.948, 51.823}, {5.931, 51.816}, {5.928, 51.807}, {5.951, 51.796},
+			{5.964, 51.777}, {5.962, 51.756}, {5.94, 51.743}, {5.939, 51.732}, {5.978, 51.73},
+			{6.012, 51.716}, {6.022, 51.71}, {6.009, 51.681}, {6.031, 51.663}, {6.099, 51.644},
+			{6.082, 51.608}, {6.086, 51.596}, {6.153, 51.538}, {6.193, 51.509}, {6.205, 51.458},
+			{6.194, 51.417}, {6.193, 51.399}, {6.208, 51.388}, {6.202, 51.379}, {6.199, 51.357},
+			{6.194, 51.345}, {6.184, 51.336}, {6.148, 51.318}, {6.097, 51.259}, {6.065, 51.232},
+			{6.057, 51.212}, {6.054, 51.189}, {6.061, 51.171}, {6.084, 51.163}, {6.134, 51.184},
+			{6.157, 51.179}, {6.126, 51.165}, {6.147, 51.152}, {6.132, 51.14}, {6.075, 51.12},
+			{5.983, 51.075}, {5.969, 51.065}, {5.95, 51.039}, {5.938, 51.031}, {5.92, 51.03},
+			{5.894, 51.047}, {5.88, 51.052}, {5.852, 51.043}, {5.858, 51.019}, {5.874, 50.991},
+			{5.875, 50.965}, {5.928, 50.978}, {5.968, 50.971}, {6.004, 50.974}, {6.006, 50.969},
+			{5.996, 50.952}, {6, 50.929}, {6.031, 50.915}, {6.064, 50.908}, {6.055, 50.891}, {6.063, 50.871},
+			{6.063, 50.863}, {6.057, 50.853}, {6.046, 50.844}, {6.023, 50.845}, {6.009, 50.84}, {6, 50.83},
+			{6.004, 50.813}, {5.998, 50.801}, {5.984, 50.792}, {5.969, 50.795}, {5.973, 50.782},
+			{5.997, 50.763}, {5.995, 50.75}, {5.975, 50.748}, {5.902, 50.749}, {5.88, 50.754},
+			{5.793, 50.752}, {5.762, 50.771}, {5.752, 50.773}, {5.744, 50.769}, {5.728, 50.754},
+			{5.707, 50.754}, {5.688, 50.761}, {5.699, 50.784}, {5.7, 50.796}, {5.663, 50.805},
+			{5.641, 50.815}, {5.624, 50.83}, {5.622, 50.853}, {5.694, 50.904}, {5.722, 50.912},
+			{5.764, 50.959}, {5.745, 50.963}, {5.722, 50.959}, {5.762, 50.999}, {5.778, 51.024},
+			{5.764, 51.035}, {5.771, 51.058}, {5.78, 51.061}, {5.791, 51.055}, {5.799, 51.061},
+			{5.812, 51.082}, {5.805, 51.097}, {5.827, 51.097}, {5.846, 51.103}, {5.823, 51.119},
+			{5.826, 51.13}, {5.841, 51.139}, {5.829, 51.156}, {5.812, 51.157}, {5.781, 51.152},
+			{5.768, 51.159}, {5.747, 51.178}, {5.731, 51.184}, {5.659, 51.179}, {5.625, 51.197},
+			{5.568, 51.208}, {5.546, 51.22}, {5.542, 51.243}, {5.535, 51.262}, {5.517, 51.277},
+			{5.493, 51.287}, {5.472, 51.288}, {5.41, 51.264}, {5.389, 51.259}, {5.271, 51.262},
+			{5.233, 51.256}, {5.215, 51.259}, {5.215, 51.294}, {5.197, 51.308}, {5.14, 51.307},
+			{5.123, 51.313}, {5.103, 51.351}, {5.068, 51.375}, {5.062, 51.384}, {5.065, 51.406},
+			{5.076, 51.422}, {5.08, 51.439}, {5.06, 51.462}, {5.028, 51.477}, {5.012, 51.474},
+			{5.006, 51.468}, {4.996, 51.447}, {4.957, 51.411}, {4.932, 51.396}, {4.91, 51.392},
+			{4.853, 51.406}, {4.783, 51.408}, {4.762, 51.413}, {4.768, 51.425}, {4.779, 51.426},
+			{4.823, 51.414}, {4.815, 51.431}, {4.826, 51.461}, {4.824, 51.476}, {4.814, 51.484},
+			{4.779, 51.495}, {4.764, 51.496}, {4.731, 51.486}, {4.654, 51.426}, {4.63, 51.418},
+			{4.615, 51.418}, {4.583, 51.423}, {4.541, 51.42}, {4.522, 51.429}, {4.523, 51.438},
+			{4.532, 51.451}, {4.533, 51.468}, {4.525, 51.476}, {4.483, 51.474}, {4.429, 51.462},
+			{4.377, 51.443}, {4.389, 51.435}, {4.381, 51.42}, {4.38, 51.41}, {4.41, 51.384}, {4.416, 51.374},
+			{4.411, 51.357}, {4.392, 51.35}, {4.346, 51.352}, {4.29, 51.369}, {4.261, 51.369},
+			{4.257, 51.373}, {4.235, 51.419}, {4.201, 51.405}, {4.111, 51.407}, {4.015, 51.449},
+			{3.957, 51.456}, {3.902, 51.405}, {3.827, 51.391}, {3.69, 51.454}, {3.61, 51.445},
+			{3.535, 51.459}, {3.447, 51.546}, {3.56, 51.595}, {3.851, 51.611}, {3.906, 51.569},
+			{3.872, 51.549}, {4.016, 51.531}, {4.057, 51.508}, {4.105, 51.446}, {4.283, 51.448},
+			{4.297, 51.471}, {4.268, 51.508}, {4.091, 51.535}, {3.988, 51.59}, {4.068, 51.611},
+			{4.157, 51.608}, {4.207, 51.59}, {4.19, 51.617}, {4.136, 51.62}, {4.113, 51.639},
+			{4.113, 51.671}, {4.177, 51.686}, {4.072, 51.717}, {4.034, 51.784}, {3.981, 51.81},
+			{3.872, 51.788}, {3.862, 51.816}, {3.963, 51.847}, {4.048, 51.834}, {4.078, 51.844},
+			{4.032, 51.882}, {4.043, 51.909}, {4.016, 51.988}, {4.142, 52.006}, {4.285, 52.113},
+			{4.508, 52.336}, {4.597, 52.507}, {4.669, 52.797}, {4.712, 52.863}, {4.745, 52.968},
+			{4.817, 52.968}, {4.809, 52.93}, {4.874, 52.904}, {5.078, 52.954}, {5.313, 53.083},
+			{5.379, 53.097}, {5.447, 53.221}, {5.592, 53.303}, {5.981, 53.406}, {6.274, 53.413},
+			{6.312, 53.399}, {6.494, 53.439}, {6.73, 53.461}, {6.829, 53.451}, {6.867, 53.434},
+			{6.901, 53.352}, {7.02, 53.306}, {7.085, 53.31}, {7.073, 53.296}, {7.081, 53.267},
+			{7.183, 53.245},
+		},
+	},
+	{
+		{
+			{3.349, 51.375}, {3.511, 51.408}, {3.541, 51.417}, {3.756, 51.35}, {3.834, 51.343},
+			{3.956, 51.372}, {3.984, 51.41}, {4.122, 51.359}, {4.2, 51.376}, {4.221, 51.368},
+			{4.208, 51.331}, {4.169, 51.297}, {4.119, 51.272}, {3.995, 51.233}, {3.949, 51.211},
+			{3.927, 51.206}, {3.809, 51.205}, {3.796, 51.209}, {3.781, 51.22}, {3.781, 51.241},
+			{3.776, 51.255}, {3.757, 51.262}, {3.635, 51.288}, {3.61, 51.29}, {3.514, 51.281},
+			{3.503, 51.274}, {3.502, 51.262}, {3.504, 51.25}, {3.501, 51.24}, {3.454, 51.236},
+			{3.422, 51.238}, {3.392, 51.247}, {3.367, 51.263}, {3.353, 51.289}, {3.358, 51.337},
+		},
+	},
+	{
+		{
+			{4.037, 51.686}, {4.097, 51.67}, {4.099, 51.654}, {4.073, 51.637}, {3.978, 51.623},
+			{3.902, 51.642}, {3.886, 51.668}, {3.809, 51.7}, {3.765, 51.678}, {3.721, 51.677},
+			{3.687, 51.693}, {3.689, 51.725}, {3.814, 51.748}, {3.962, 51.738},
+		},
+	},
+	{
+		{
+			{4.893, 53.111}, {4.893, 53.105}, {4.913, 53.105}, {4.893, 53.076}, {4.865, 53.05},
+			{4.818, 53.027}, {4.796, 53}, {4.762, 52.994}, {4.727, 53.003}, {4.708, 53.028}, {4.714, 53.055},
+			{4.734, 53.084}, {4.758, 53.108}, {4.806, 53.133}, {4.854, 53.188}, {4.886, 53.187},
+			{4.89, 53.181}, {4.891, 53.171}, {4.886, 53.166}, {4.91, 53.135}, {4.91, 53.119},
+		},
+	},
+	{
+		{
+			{4.879, 53.221}, {4.921, 53.254}, {4.987, 53.29}, {5.054, 53.314}, {5.105, 53.31},
+			{5.105, 53.303}, {5.036, 53.292}, {4.91, 53.212},
+		},
+	},
+	{
+		{
+			{5.424, 53.43}, {5.585, 53.454}, {5.562, 53.434}, {5.475, 53.427}, {5.482, 53.413},
+			{5.469, 53.409}, {5.208, 53.353}, {5.18, 53.353}, {5.168, 53.368}, {5.179, 53.385},
+			{5.25, 53.406},
+		},
+	},
+	{
+		{
+			{5.674, 53.468}, {5.914, 53.474}, {5.955, 53.461}, {5.856, 53.447}, {5.753, 53.447},
+			{5.679, 53.43}, {5.654, 53.434}, {5.636, 53.449}, {5.642, 53.463},
+		},
+	},
+	{
+		{
+			{6.212, 53.509}, {6.311, 53.514}, {6.339, 53.509}, {6.339, 53.502}, {6.153, 53.469},
+			{6.133, 53.454}, {6.127, 53.463}, {6.133, 53.468}, {6.123, 53.482}, {6.148, 53.495},
+		},
+	},
+	{
+		{
+			{6.441, 53.557}, {6.455, 53.558}, {6.51, 53.544}, {6.502, 53.543}, {6.496, 53.536},
+			{6.461, 53.543}, {6.443, 53.55},
+		},
+	},
+})
+
+// belgium is Belgium.
+var belgium = newBoundary(MultiPolygon{
+	{
+		{
+			{2.522, 51.088}, {2.542, 51.097}, {3.125, 51.33}, {3.349, 51.375}, {3.358, 51.337},
+			{3.353, 51.289}, {3.367, 51.263}, {3.392, 51.247}, {3.422, 51.238}, {3.454, 51.236},
+			{3.501, 51.24}, {3.504, 51.25}, {3.502, 51.262}, {3.503, 51.274}, {3.514, 51.281}, {3.61, 51.29},
+			{3.635, 51.288}, {3.757, 51.262}, {3.776, 51.255}, {3.781, 51.241}, {3.781, 51.22},
+			{3.796, 51.209}, {3.809, 51.205}, {3.927, 51.206}, {3.949, 51.211}, {3.995, 51.233},
+			{4.119, 51.272}, {4.169, 51.297}, {4.208, 51.331}, {4.221, 51.368}, {4.222, 51.368},
+			{4.303, 51.296}, {4.297, 51.268}, {4.331, 51.296}, {4.289, 51.309}, {4.276, 51.358},
+			{4.261, 51.369}, {4.29, 51.369}, {4.346, 51.352}, {4.392, 51.35}, {4.411, 51.357},
+			{4.416, 51.374}, {4.41, 51.384}, {4.38, 51.41}, {4.381, 51.42}, {4.389, 51.435}, {4.377, 51.443},
+			{4.429, 51.462}, {4.483, 51.474}, {4.525, 51.476}, {4.533, 51.468}, {4.532, 51.451},
+			{4.523, 51.438}, {4.522, 51.429}, {4.541, 51.42}, {4.583, 51.423}, {4.615, 51.418},
+			{4.63, 51.418}, {4.654, 51.426}, {4.731, 51.486}, {4.764, 51.496}, {4.779, 51.495},
+			{4.814, 51.484}, {4.824, 51.476}, {4.826, 51.461}, {4.815, 51.431}, {4.823, 51.414},
+			{4.779, 51.426}, {4.768, 51.425}, {4.762, 51.413}, {4.783, 51.408}, {4.853, 51.406},
+			{4.91, 51.392}, {4.932, 51.396}, {4.957, 51.411}, {4.996, 51.447}, {5.006, 51.468},
+			{5.012, 51.474}, {5.028, 51.477}, {5.06, 51.462}, {5.08, 51.439}, {5.076, 51.422},
+			{5.065, 51.406}, {5.062, 51.384}, {5.068, 51.375}, {5.103, 51.351}, {5.123, 51.313},
+			{5.14, 51.307}, {5.197, 51.308}, {5.215, 51.294}, {5.215, 51.259}, {5.233, 51.256},
+			{5.271, 51.262}, {5.389, 51.259}, {5.41, 51.264}, {5.472, 51.288}, {5.493, 51.287},
+			{5.517, 51.277}, {5.535, 51.262}, {5.542, 51.243}, {5.546, 51.22}, {5.568, 51.208},
+			{5.625, 51.197}, {5.659, 51.179}, {5.731, 51.184}, {5.747, 51.178}, {5.768, 51.159},
+			{5.781, 51.152}, {5.812, 51.157}, {5.829, 51.156}, {5.841, 51.139}, {5.826, 51.13},
+			{5.823, 51.119}, {5.846, 51.103}, {5.827, 51.097}, {5.805, 51.097}, {5.812, 51.082},
+			{5.799, 51.061}, {5.791, 51.055}, {5.78, 51.061}, {5.771, 51.058}, {5.764, 51.035},
+			{5.778, 51.024}, {5.762, 50.999}, {5.722, 50.959}, {5.745, 50.963}, {5.764, 50.959},
+			{5.722, 50.912}, {5.694, 50.904}, {5.622, 50.853}, {5.624, 50.83}, {5.641, 50.815},
+			{5.663, 50.805}, {5.7, 50.796}, {5.699, 50.784}, {5.688, 50.761}, {5.707, 50.754},
+			{5.728, 50.754}, {5.744, 50.769}, {5.752, 50.773}, {5.762, 50.771}, {5.793, 50.752},
+			{5.88, 50.754}, {5.902, 50.749}, {5.975, 50.748}, {5.995, 50.75}, {5.993, 50.748},
+			{5.994, 50.743}, {6.011, 50.737}, {6.011, 50.727}, {6.008, 50.717}, {6.012, 50.709},
+			{6.025, 50.708}, {6.064, 50.714}, {6.081, 50.713}, {6.1, 50.701}, {6.161, 50.642},
+			{6.148, 50.637}, {6.159, 50.622}, {6.18, 50.616}, {6.249, 50.614}, {6.251, 50.608},
+			{6.233, 50.591}, {6.233, 50.587}, {6.179, 50.562}, {6.161, 50.544}, {6.177, 50.53},
+			{6.169, 50.522}, {6.171, 50.518}, {6.191, 50.515}, {6.183, 50.507}, {6.207, 50.486},
+			{6.256, 50.495}, {6.275, 50.488}, {6.337, 50.481}, {6.326, 50.474}, {6.323, 50.466},
+			{6.332, 50.447}, {6.34, 50.438}, {6.351, 50.434}, {6.35, 50.417}, {6.336, 50.38},
+			{6.338, 50.368}, {6.372, 50.329}, {6.375, 50.315}, {6.362, 50.307}, {6.335, 50.304},
+			{6.299, 50.309}, {6.286, 50.304}, {6.269, 50.286}, {6.267, 50.276}, {6.272, 50.267},
+			{6.253, 50.257}, {6.19, 50.243}, {6.18, 50.233}, {6.157, 50.223}, {6.147, 50.214},
+			{6.16, 50.205}, {6.166, 50.193}, {6.165, 50.182}, {6.159, 50.172}, {6.152, 50.169},
+			{6.132, 50.168}, {6.123, 50.165}, {6.116, 50.145}, {6.126, 50.14}, {6.129, 50.134},
+			{6.127, 50.127}, {6.117, 50.12}, {6.103, 50.125}, {6.101, 50.142}, {6.097, 50.151},
+			{6.084, 50.159}, {6.076, 50.159}, {6.061, 50.151}, {6.039, 50.148}, {6.014, 50.154},
+			{6.005, 50.17}, {5.998, 50.175}, {5.983, 50.167}, {5.962, 50.166}, {5.953, 50.156},
+			{5.941, 50.128}, {5.926, 50.118}, {5.889, 50.107}, {5.872, 50.097}, {5.864, 50.067},
+			{5.838, 50.047}, {5.839, 50.026}, {5.836, 50.019}, {5.809, 50.008}, {5.803, 50.002},
+			{5.802, 49.989}, {5.813, 49.978}, {5.802, 49.964}, {5.793, 49.959}, {5.772, 49.954},
+			{5.764, 49.947}, {5.754, 49.93}, {5.719, 49.891}, {5.715, 49.882}, {5.749, 49.867},
+			{5.758, 49.858}, {5.732, 49.86}, {5.727, 49.845}, {5.737, 49.839}, {5.725, 49.834},
+			{5.72, 49.825}, {5.722, 49.813}, {5.728, 49.8}, {5.738, 49.789}, {5.759, 49.784},
+			{5.772, 49.779}, {5.778, 49.773}, {5.802, 49.743}, {5.805, 49.722}, {5.832, 49.714},
+			{5.85, 49.714}, {5.856, 49.708}, {5.857, 49.7}, {5.849, 49.693}, {5.842, 49.674},
+			{5.846, 49.667}, {5.853, 49.663}, {5.873, 49.662}, {5.88, 49.658}, {5.885, 49.644},
+			{5.88, 49.635}, {5.862, 49.623}, {5.841, 49.59}, {5.83, 49.583}, {5.847, 49.577},
+			{5.837, 49.561}, {5.814, 49.545}, {5.791, 49.538}, {5.779, 49.54}, {5.757, 49.548},
+			{5.746, 49.549}, {5.736, 49.546}, {5.723, 49.535}, {5.71, 49.531}, {5.645, 49.544},
+			{5.62, 49.536}, {5.608, 49.525}, {5.602, 49.514}, {5.579, 49.514}, {5.548, 49.523},
+			{5.478, 49.495}, {5.456, 49.499}, {5.45, 49.508}, {5.454, 49.537}, {5.45, 49.546},
+			{5.402, 49.602}, {5.383, 49.613}, {5.335, 49.626}, {5.322, 49.613}, {5.298, 49.613},
+			{5.293, 49.617}, {5.291, 49.626}, {5.294, 49.641}, {5.306, 49.648}, {5.299, 49.662},
+			{5.259, 49.691}, {5.238, 49.691}, {5.192, 49.683}, {5.169, 49.687}, {5.136, 49.705},
+			{5.094, 49.745}, {5.082, 49.753}, {5.046, 49.759}, {5.026, 49.767}, {4.989, 49.791},
+			{4.971, 49.797}, {4.95, 49.796}, {4.884, 49.784}, {4.858, 49.787}, {4.849, 49.794},
+			{4.849, 49.832}, {4.835, 49.853}, {4.837, 49.859}, {4.849, 49.871}, {4.859, 49.892},
+			{4.862, 49.9}, {4.86, 49.913}, {4.845, 49.932}, {4.797, 49.944}, {4.784, 49.958},
+			{4.788, 49.974}, {4.827, 50.036}, {4.827, 50.064}, {4.846, 50.091}, {4.852, 50.092},
+			{4.863, 50.084}, {4.871, 50.122}, {4.872, 50.14}, {4.863, 50.148}, {4.831, 50.143},
+			{4.82, 50.146}, {4.816, 50.161}, {4.789, 50.153}, {4.682, 50.084}, {4.673, 50.066},
+			{4.681, 50.048}, {4.673, 50.016}, {4.666, 50.001}, {4.657, 49.989}, {4.646, 49.984},
+			{4.604, 49.98}, {4.465, 49.936}, {4.435, 49.932}, {4.278, 49.96}, {4.201, 49.953},
+			{4.132, 49.975}, {4.14, 49.975}, {4.133, 50.004}, {4.128, 50.006}, {4.137, 50.025},
+			{4.163, 50.042}, {4.21, 50.06}, {4.21, 50.067}, {4.197, 50.084}, {4.181, 50.127}, {4.171, 50.13},
+			{4.14, 50.124}, {4.126, 50.128}, {4.128, 50.146}, {4.147, 50.186}, {4.153, 50.205},
+			{4.168, 50.222}, {4.201, 50.242}, {4.204, 50.252}, {4.198, 50.258}, {4.163, 50.273},
+			{4.15, 50.27}, {4.151, 50.256}, {4.144, 50.252}, {4.125, 50.257}, {4.098, 50.295},
+			{4.056, 50.315}, {4.023, 50.338}, {4.003, 50.344}, {3.982, 50.343}, {3.919, 50.325},
+			{3.896, 50.325}, {3.84, 50.345}, {3.799, 50.348}, {3.755, 50.346}, {3.742, 50.343},
+			{3.733, 50.337}, {3.708, 50.306}, {3.701, 50.305}, {3.697, 50.298}, {3.662, 50.319},
+			{3.653, 50.36}, {3.653, 50.407}, {3.644, 50.446}, {3.628, 50.464}, {3.608, 50.477},
+			{3.564, 50.487}, {3.499, 50.487}, {3.487, 50.493}, {3.496, 50.504}, {3.498, 50.512},
+			{3.491, 50.517}, {3.477, 50.52}, {3.463, 50.519}, {3.429, 50.502}, {3.385, 50.492},
+			{3.361, 50.49}, {3.3, 50.507}, {3.271, 50.527}, {3.266, 50.538}, {3.264, 50.57}, {3.238, 50.626},
+			{3.23, 50.655}, {3.244, 50.672}, {3.232, 50.696}, {3.224, 50.705}, {3.197, 50.709},
+			{3.189, 50.715}, {3.177, 50.734}, {3.146, 50.769}, {3.129, 50.779}, {3.103, 50.784},
+			{2.971, 50.758}, {2.948, 50.749}, {2.93, 50.736}, {2.9, 50.703}, {2.887, 50.697},
+			{2.787, 50.723}, {2.768, 50.733}, {2.744, 50.766}, {2.707, 50.789}, {2.692, 50.809},
+			{2.679, 50.813}, {2.642, 50.812}, {2.62, 50.816}, {2.607, 50.835}, {2.587, 50.845},
+			{2.597, 50.868}, {2.577, 50.912}, {2.583, 50.921}, {2.612, 50.941}, {2.608, 50.961},
+			{2.593, 50.976}, {2.557, 51.001}, {2.547, 51.02}, {2.537, 51.065},
+		},
+	},
+})
+
+// switzerland is Switzerland.
+var switzerland = newBoundary(MultiPolygon{
+	{
+		{
+			{10.454, 46.864}, {10.449, 46.832}, {10.439, 46.817}, {10.417, 46.799}, {10.419, 46.784},
+			{10.426, 46.769}, {10.429, 46.756}, {10.417, 46.743}, {10.4, 46.736}, {10.396, 46.726},
+			{10.394, 46.701}, {10.385, 46.689}, {10.374, 46.682}, {10.369, 46.672}, {10.378, 46.653},
+			{10.396, 46.639}, {10.438, 46.636}, {10.459, 46.624}, {10.467, 46.604}, {10.466, 46.578},
+			{10.458, 46.554}, {10.444, 46.538}, {10.426, 46.535}, {10.354, 46.548}, {10.319, 46.546},
+			{10.295, 46.551}, {10.276, 46.566}, {10.235, 46.575}, {10.23, 46.586}, {10.236, 46.607},
+			{10.234, 46.618}, {10.218, 46.627}, {10.192, 46.627}, {10.088, 46.604}, {10.071, 46.564},
+			{10.033, 46.533}, {10.031, 46.504}, {10.027, 46.493}, {10.028, 46.484}, {10.035, 46.471},
+			{10.044, 46.467}, {10.026, 46.446}, {10.042, 46.433}, {10.133, 46.414}, {10.141, 46.403},
+			{10.133, 46.381}, {10.105, 46.361}, {10.097, 46.352}, {10.092, 46.338}, {10.092, 46.329},
+			{10.105, 46.309}, {10.146, 46.28}, {10.159, 46.262}, {10.146, 46.243}, {10.118, 46.231},
+			{10.076, 46.22}, {10.043, 46.22}, {10.042, 46.243}, {10.032, 46.26}, {9.992, 46.284},
+			{9.978, 46.298}, {9.971, 46.32}, {9.971, 46.34}, {9.964, 46.356}, {9.939, 46.367},
+			{9.918, 46.371}, {9.899, 46.372}, {9.855, 46.367}, {9.768, 46.339}, {9.755, 46.341},
+			{9.731, 46.351}, {9.72, 46.351}, {9.709, 46.342}, {9.708, 46.312}, {9.693, 46.297},
+			{9.674, 46.292}, {9.56, 46.293}, {9.536, 46.299}, {9.515, 46.309}, {9.503, 46.321},
+			{9.483, 46.357}, {9.444, 46.375}, {9.438, 46.492}, {9.435, 46.498}, {9.411, 46.489},
+			{9.395, 46.469}, {9.385, 46.466}, {9.352, 46.485}, {9.351, 46.498}, {9.331, 46.502},
+			{9.282, 46.497}, {9.263, 46.485}, {9.246, 46.461}, {9.238, 46.437}, {9.248, 46.423},
+			{9.261, 46.417}, {9.263, 46.407}, {9.26, 46.38}, {9.274, 46.344}, {9.275, 46.331},
+			{9.269, 46.309}, {9.24, 46.267}, {9.225, 46.231}, {9.204, 46.214}, {9.181, 46.204},
+			{9.163, 46.172}, {9.091, 46.138}, {9.072, 46.119}, {9.068, 46.106}, {9.07, 46.083},
+			{9.067, 46.071}, {9.059, 46.062}, {9.028, 46.053}, {9.002, 46.039}, {8.998, 46.028},
+			{9.016, 45.993}, {8.983, 45.972}, {8.981, 45.964}, {8.993, 45.954}, {9.002, 45.936},
+			{9.011, 45.927}, {9.052, 45.916}, {9.063, 45.899}, {9.059, 45.882}, {9.034, 45.848},
+			{9.002, 45.821}, {8.972, 45.825}, {8.94, 45.835}, {8.9, 45.826}, {8.914, 45.866},
+			{8.912, 45.883}, {8.898, 45.91}, {8.871, 45.947}, {8.858, 45.957}, {8.8, 45.979},
+			{8.768, 45.983}, {8.791, 46.019}, {8.82, 46.043}, {8.834, 46.066}, {8.809, 46.09},
+			{8.794, 46.093}, {8.747, 46.094}, {8.739, 46.098}, {8.732, 46.107}, {8.724, 46.11},
+			{8.695, 46.095}, {8.677, 46.096}, {8.631, 46.115}, {8.602, 46.123}, {8.539, 46.188},
+			{8.51, 46.208}, {8.457, 46.225}, {8.438, 46.235}, {8.427, 46.251}, {8.423, 46.276},
+			{8.427, 46.302}, {8.443, 46.353}, {8.446, 46.412}, {8.442, 46.435}, {8.428, 46.449},
+			{8.399, 46.452}, {8.343, 46.444}, {8.316, 46.434}, {8.295, 46.418}, {8.287, 46.405},
+			{8.297, 46.398}, {8.297, 46.388}, {8.282, 46.37}, {8.242, 46.354}, {8.193, 46.309},
+			{8.172, 46.299}, {8.128, 46.292}, {8.107, 46.286}, {8.087, 46.272}, {8.073, 46.254},
+			{8.1, 46.236}, {8.13, 46.196}, {8.132, 46.159}, {8.111, 46.127}, {8.067, 46.101},
+			{8.035, 46.097}, {8.025, 46.091}, {8.018, 46.081}, {8.011, 46.03}, {7.998, 46.011},
+			{7.979, 45.995}, {7.898, 45.982}, {7.884, 45.974}, {7.873, 45.959}, {7.87, 45.94}, {7.85, 45.94},
+			{7.844, 45.919}, {7.831, 45.914}, {7.808, 45.918}, {7.78, 45.918}, {7.732, 45.93},
+			{7.706, 45.926}, {7.694, 45.929}, {7.674, 45.95}, {7.643, 45.966}, {7.541, 45.984},
+			{7.524, 45.978}, {7.504, 45.957}, {7.483, 45.955}, {7.453, 45.946}, {7.394, 45.916},
+			{7.362, 45.908}, {7.287, 45.913}, {7.184, 45.88}, {7.154, 45.877}, {7.121, 45.876},
+			{7.09, 45.881}, {7.067, 45.89}, {7.022, 45.925}, {7.015, 45.933}, {6.988, 45.993},
+			{6.915, 46.049}, {6.892, 46.056}, {6.869, 46.044}, {6.851, 46.05}, {6.853, 46.076},
+			{6.851, 46.086}, {6.849, 46.085}, {6.868, 46.105}, {6.869, 46.112}, {6.854, 46.123},
+			{6.774, 46.135}, {6.766, 46.152}, {6.775, 46.186}, {6.792, 46.222}, {6.828, 46.269},
+			{6.805, 46.297}, {6.769, 46.323}, {6.75, 46.346}, {6.756, 46.357}, {6.782, 46.378},
+			{6.789, 46.395}, {6.787, 46.414}, {6.778, 46.424}, {6.763, 46.429}, {6.614, 46.456},
+			{6.547, 46.457}, {6.483, 46.449}, {6.398, 46.408}, {6.365, 46.402}, {6.332, 46.401},
+			{6.302, 46.394}, {6.269, 46.375}, {6.219, 46.329}, {6.214, 46.315}, {6.238, 46.268},
+			{6.252, 46.26}, {6.269, 46.265}, {6.276, 46.263}, {6.281, 46.24}, {6.255, 46.221},
+			{6.191, 46.192}, {6.14, 46.15}, {6.108, 46.139}, {6.074, 46.149}, {6.028, 46.148},
+			{5.983, 46.14}, {5.959, 46.13}, {5.983, 46.171}, {5.965, 46.186}, {5.955, 46.2}, {5.959, 46.212},
+			{6.043, 46.243}, {6.062, 46.241}, {6.09, 46.246}, {6.094, 46.253}, {6.094, 46.273},
+			{6.101, 46.301}, {6.136, 46.359}, {6.135, 46.37}, {6.123, 46.386}, {6.108, 46.396},
+			{6.054, 46.419}, {6.066, 46.427}, {6.068, 46.434}, {6.065, 46.451}, {6.06, 46.46},
+			{6.064, 46.471}, {6.076, 46.48}, {6.11, 46.521}, {6.146, 46.552}, {6.122, 46.57},
+			{6.118, 46.583}, {6.132, 46.596}, {6.266, 46.68}, {6.338, 46.707}, {6.374, 46.734},
+			{6.407, 46.746}, {6.429, 46.761}, {6.433, 46.769}, {6.433, 46.786}, {6.417, 46.802},
+			{6.434, 46.84}, {6.447, 46.858}, {6.448, 46.872}, {6.445, 46.883}, {6.428, 46.909},
+			{6.443, 46.944}, {6.491, 46.963}, {6.599, 46.987}, {6.665, 47.021}, {6.688, 47.044},
+			{6.676, 47.062}, {6.69, 47.078}, {6.699, 47.085}, {6.724, 47.091}, {6.728, 47.097},
+			{6.746, 47.104}, {6.745, 47.121}, {6.775, 47.128}, {6.84, 47.17}, {6.859, 47.191},
+			{6.888, 47.211}, {6.956, 47.245}, {6.952, 47.27}, {6.959, 47.291}, {6.977, 47.304},
+			{6.992, 47.306}, {7.006, 47.319}, {7.037, 47.33}, {7.044, 47.34}, {7.034, 47.351},
+			{7.004, 47.368}, {6.986, 47.362}, {6.867, 47.354}, {6.872, 47.367}, {6.884, 47.383},
+			{6.899, 47.396}, {6.925, 47.406}, {6.926, 47.425}, {6.952, 47.429}, {6.969, 47.435},
+			{6.983, 47.444}, {6.991, 47.452}, {6.976, 47.478}, {6.973, 47.489}, {7.01, 47.499},
+			{7.028, 47.493}, {7.054, 47.49}, {7.104, 47.496}, {7.154, 47.486}, {7.181, 47.488},
+			{7.163, 47.46}, {7.168, 47.444}, {7.19, 47.435}, {7.219, 47.428}, {7.23, 47.419},
+			{7.238, 47.417}, {7.283, 47.429}, {7.309, 47.433}, {7.379, 47.431}, {7.406, 47.438},
+			{7.426, 47.456}, {7.429, 47.465}, {7.428, 47.471}, {7.414, 47.484}, {7.414, 47.49},
+			{7.426, 47.493}, {7.467, 47.482}, {7.484, 47.493}, {7.486, 47.498}, {7.478, 47.508},
+			{7.477, 47.515}, {7.501, 47.517}, {7.505, 47.523}, {7.505, 47.533}, {7.483, 47.542},
+			{7.526, 47.566}, {7.585, 47.584}, {7.586, 47.585}, {7.637, 47.595}, {7.66, 47.597},
+			{7.647, 47.572}, {7.636, 47.565}, {7.61, 47.565}, {7.661, 47.546}, {7.683, 47.544},
+			{7.767, 47.556}, {7.786, 47.563}, {7.82, 47.595}, {7.834, 47.59}, {7.898, 47.588},
+			{7.904, 47.584}, {7.912, 47.561}, {8.042, 47.561}, {8.087, 47.567}, {8.097, 47.572},
+			{8.114, 47.588}, {8.144, 47.6}, {8.162, 47.604}, {8.179, 47.616}, {8.233, 47.622},
+			{8.251, 47.622}, {8.289, 47.616}, {8.306, 47.592}, {8.354, 47.581}, {8.418, 47.581},
+			{8.449, 47.584}, {8.462, 47.606}, {8.492, 47.62}, {8.522, 47.622}, {8.538, 47.612},
+			{8.561, 47.589}, {8.574, 47.592}, {8.581, 47.6}, {8.583, 47.622}, {8.578, 47.633},
+			{8.58, 47.639}, {8.594, 47.643}, {8.595, 47.635}, {8.602, 47.633}, {8.607, 47.656},
+			{8.568, 47.663}, {8.52, 47.657}, {8.476, 47.64}, {8.458, 47.64}, {8.412, 47.661},
+			{8.391, 47.665}, {8.398, 47.676}, {8.391, 47.692}, {8.392, 47.7}, {8.402, 47.707},
+			{8.438, 47.723}, {8.445, 47.743}, {8.464, 47.764}, {8.472, 47.767}, {8.537, 47.774},
+			{8.552, 47.779}, {8.542, 47.795}, {8.558, 47.801}, {8.583, 47.8}, {8.602, 47.795},
+			{8.608, 47.762}, {8.617, 47.757}, {8.63, 47.763}, {8.635, 47.785}, {8.644, 47.791},
+			{8.657, 47.788}, {8.682, 47.759}, {8.713, 47.757}, {8.72, 47.747}, {8.7, 47.723},
+			{8.713, 47.709}, {8.717, 47.695}, {8.77, 47.695}, {8.762, 47.701}, {8.771, 47.721},
+			{8.798, 47.72}, {8.83, 47.707}, {8.856, 47.691}, {8.838, 47.688}, {8.838, 47.681},
+			{8.882, 47.656}, {8.906, 47.652}, {8.945, 47.654}, {8.982, 47.662}, {8.998, 47.674},
+			{9.017, 47.679}, {9.128, 47.67}, {9.183, 47.67}, {9.197, 47.656}, {9.234, 47.656},
+			{9.273, 47.65}, {9.547, 47.535}, {9.553, 47.517}, {9.555, 47.511}, {9.585, 47.481},
+			{9.622, 47.469}, {9.65, 47.452}, {9.65, 47.41}, {9.64, 47.395}, {9.601, 47.361}, {9.587, 47.328},
+			{9.553, 47.3}, {9.521, 47.263}, {9.505, 47.244}, {9.487, 47.21}, {9.485, 47.176},
+			{9.512, 47.129}, {9.512, 47.108}, {9.503, 47.095}, {9.476, 47.073}, {9.477, 47.064},
+			{9.561, 47.052}, {9.581, 47.057}, {9.6, 47.053}, {9.652, 47.058}, {9.669, 47.056},
+			{9.858, 47.015}, {9.856, 47.004}, {9.871, 46.999}, {9.861, 46.949}, {9.862, 46.94},
+			{9.875, 46.927}, {9.9, 46.914}, {10.007, 46.891}, {10.046, 46.866}, {10.068, 46.857},
+			{10.111, 46.847}, {10.132, 46.847}, {10.158, 46.852}, {10.201, 46.867}, {10.212, 46.877},
+			{10.22, 46.906}, {10.235, 46.923}, {10.251, 46.925}, {10.271, 46.922}, {10.296, 46.923},
+			{10.296, 46.941}, {10.314, 46.964}, {10.339, 46.984}, {10.373, 46.996}, {10.384, 46.993},
+			{10.416, 46.962}, {10.458, 46.937}, {10.464, 46.92}, {10.451, 46.886},
+		},
+	},
+})
+
+// newZealand is the North, South and Stewart Island with the near islands.
+var newZealand = newBoundary(MultiPolygon{
+	{
+		{
+			{166.637, -47.994}, {166.632, -48}, {166.624, -48.021}, {166.634, -48.04}, {166.637, -48.051},
+			{166.637, -48.062}, {166.628, -48.059}, {166.613, -48.038}, {166.605, -48.035},
+			{166.568, -48.042}, {166.568, -48.034}, {166.619, -47.998},
+		},
+	},
+	{
+		{
+			{167.419, -47.233}, {167.431, -47.219}, {167.454, -47.207}, {167.477, -47.206},
+			{167.487, -47.219}, {167.449, -47.239}, {167.427, -47.245},
+		},
+	},
+	{
+		{
+			{167.709, -46.784}, {167.698, -46.792}, {167.683, -46.795}, {167.669, -46.792},
+			{167.663, -46.778}, {167.667, -46.765}, {167.679, -46.759}, {167.693, -46.757},
+			{167.707, -46.76}, {167.72, -46.767},
+		},
+	},
+	{
+		{
+			{168.59, -46.732}, {168.606, -46.755}, {168.594, -46.775}, {168.563, -46.801},
+			{168.568, -46.791}, {168.55, -46.772}, {168.542, -46.76}, {168.558, -46.759}, {168.572, -46.754},
+			{168.584, -46.746},
+		},
+	},
+	{
+		{
+			{168.199, -47.048}, {168.258, -47.078}, {168.252, -47.088}, {168.191, -47.067},
+			{168.181, -47.104}, {168.146, -47.094}, {168.158, -47.109}, {168.021, -47.116},
+			{167.902, -47.188}, {167.838, -47.168}, {167.789, -47.171}, {167.765, -47.137},
+			{167.72, -47.157}, {167.713, -47.185}, {167.697, -47.177}, {167.617, -47.212},
+			{167.635, -47.226}, {167.727, -47.205}, {167.672, -47.261}, {167.672, -47.236},
+			{167.659, -47.236}, {167.609, -47.27}, {167.521, -47.273}, {167.522, -47.228},
+			{167.546, -47.188}, {167.63, -47.157}, {167.613, -47.149}, {167.627, -47.065},
+			{167.672, -47.037}, {167.727, -47.041}, {167.744, -47.027}, {167.737, -46.955},
+			{167.779, -46.95}, {167.814, -46.911}, {167.811, -46.875}, {167.758, -46.807},
+			{167.774, -46.784}, {167.76, -46.756}, {167.787, -46.697}, {167.882, -46.7}, {167.921, -46.683},
+			{167.968, -46.7}, {168.144, -46.852}, {168.179, -46.864}, {168.191, -46.877}, {168.172, -46.89},
+			{168.192, -46.904}, {168.167, -46.91}, {168.077, -46.904}, {168.083, -46.89}, {168.008, -46.895},
+			{168.015, -46.926}, {167.967, -46.973}, {168.049, -46.932}, {168.151, -46.966},
+			{168.131, -46.992}, {168.23, -46.972}, {168.261, -46.999}, {168.247, -47.041},
+		},
+	},
+	{
+		{
+			{166.711, -45.616}, {166.73, -45.627}, {166.752, -45.669}, {166.751, -45.712},
+			{166.739, -45.728}, {166.699, -45.739}, {166.682, -45.719}, {166.675, -45.737},
+			{166.65, -45.739}, {166.637, -45.677}, {166.623, -45.658}, {166.606, -45.66}, {166.543, -45.712},
+			{166.506, -45.725}, {166.521, -45.694}, {166.567, -45.637},
+		},
+	},
+	{
+		{
+			{166.974, -45.163}, {166.997, -45.233}, {167.034, -45.3}, {167.001, -45.305}, {166.987, -45.302},
+			{166.954, -45.282}, {166.912, -45.27}, {166.893, -45.243}, {166.891, -45.236},
+			{166.901, -45.232}, {166.899, -45.228}, {166.906, -45.218}, {166.966, -45.15},
+		},
+	},
+	{
+		{
+			{174.381, -41.106}, {174.397, -41.108}, {174.401, -41.141}, {174.381, -41.195},
+			{174.366, -41.203}, {174.312, -41.203}, {174.264, -41.23}, {174.197, -41.23}, {174.216, -41.216},
+			{174.261, -41.213}, {174.312, -41.169}, {174.329, -41.182}, {174.351, -41.175},
+			{174.368, -41.159}, {174.367, -41.141}, {174.342, -41.145}, {174.326, -41.127},
+			{174.344, -41.126},
+		},
+	},
+	{
+		{
+			{173.784, -40.907}, {173.796, -40.902}, {173.803, -40.884}, {173.798, -40.873},
+			{173.784, -40.887}, {173.779, -40.875}, {173.784, -40.839}, {173.825, -40.871},
+			{173.846, -40.873}, {173.823, -40.849}, {173.812, -40.819}, {173.832, -40.758},
+			{173.842, -40.775}, {173.853, -40.778}, {173.862, -40.754}, {173.87, -40.75}, {173.885, -40.756},
+			{173.887, -40.805}, {173.895, -40.796}, {173.942, -40.778}, {173.908, -40.778},
+			{173.94, -40.755}, {173.965, -40.709}, {173.969, -40.738}, {173.956, -40.778},
+			{173.964, -40.794}, {173.935, -40.819}, {173.922, -40.867}, {173.892, -40.869},
+			{173.874, -40.887}, {173.842, -40.896}, {173.832, -40.915}, {173.805, -40.926},
+			{173.778, -40.921},
+		},
+	},
+	{
+		{
+			{172.749, -43.28}, {172.743, -43.395}, {172.73, -43.415}, {172.779, -43.558}, {172.777, -43.574},
+			{172.748, -43.567}, {172.771, -43.589}, {172.832, -43.602}, {172.708, -43.622},
+			{172.681, -43.639}, {172.743, -43.656}, {172.764, -43.636}, {172.853, -43.631},
+			{172.876, -43.669}, {172.894, -43.615}, {172.926, -43.635}, {172.928, -43.686},
+			{172.98, -43.648}, {173.009, -43.654}, {173.025, -43.676}, {173.065, -43.648},
+			{173.106, -43.696}, {173.099, -43.724}, {173.113, -43.745}, {173.114, -43.801},
+			{173.093, -43.854}, {173.081, -43.841}, {173.056, -43.864}, {173.038, -43.861},
+			{173.031, -43.889}, {173.014, -43.879}, {172.997, -43.889}, {172.962, -43.756},
+			{172.949, -43.751}, {172.927, -43.766}, {172.942, -43.786}, {172.927, -43.84},
+			{172.966, -43.905}, {172.877, -43.902}, {172.84, -43.876}, {172.817, -43.881},
+			{172.745, -43.825}, {172.763, -43.793}, {172.423, -43.855}, {172.418, -43.84}, {172.44, -43.828},
+			{172.648, -43.783}, {172.612, -43.762}, {172.551, -43.758}, {172.497, -43.722},
+			{172.417, -43.737}, {172.391, -43.773}, {172.391, -43.847}, {172.376, -43.864},
+			{172.25, -43.881}, {172.061, -43.957}, {171.868, -44.059}, {171.807, -44.061},
+			{171.667, -44.115}, {171.551, -44.167}, {171.417, -44.26}, {171.346, -44.279}, {171.28, -44.372},
+			{171.271, -44.459}, {171.228, -44.498}, {171.223, -44.528}, {171.174, -44.547},
+			{171.196, -44.567}, {171.214, -44.742}, {171.174, -44.786}, {171.202, -44.789},
+			{171.212, -44.814}, {171.203, -44.906}, {171.091, -45.074}, {170.98, -45.155},
+			{170.926, -45.238}, {170.877, -45.356}, {170.908, -45.38}, {170.906, -45.403}, {170.86, -45.447},
+			{170.866, -45.482}, {170.804, -45.529}, {170.811, -45.554}, {170.799, -45.566},
+			{170.756, -45.561}, {170.77, -45.601}, {170.684, -45.621}, {170.722, -45.643},
+			{170.698, -45.679}, {170.627, -45.706}, {170.63, -45.732}, {170.681, -45.744},
+			{170.694, -45.732}, {170.759, -45.763}, {170.759, -45.777}, {170.673, -45.801},
+			{170.557, -45.896}, {170.687, -45.852}, {170.777, -45.78}, {170.791, -45.808},
+			{170.779, -45.876}, {170.718, -45.883}, {170.7, -45.908}, {170.493, -45.923}, {170.408, -45.943},
+			{170.334, -45.982}, {170.26, -46.064}, {170.217, -46.173}, {170.096, -46.224},
+			{170.038, -46.276}, {169.899, -46.345}, {169.865, -46.378}, {169.849, -46.468},
+			{169.789, -46.492}, {169.721, -46.478}, {169.659, -46.492}, {169.756, -46.5}, {169.736, -46.534},
+			{169.704, -46.549}, {169.635, -46.578}, {169.567, -46.565}, {169.48, -46.602},
+			{169.502, -46.623}, {169.41, -46.62}, {169.392, -46.644}, {169.313, -46.627}, {169.267, -46.655},
+			{169.206, -46.659}, {169.193, -46.65}, {169.195, -46.618}, {169.18, -46.616}, {169.155, -46.662},
+			{169.118, -46.664}, {169.064, -46.637}, {169.063, -46.671}, {169.036, -46.682},
+			{168.889, -46.656}, {168.845, -46.56}, {168.665, -46.581}, {168.713, -46.561},
+			{168.618, -46.547}, {168.645, -46.578}, {168.632, -46.588}, {168.645, -46.602},
+			{168.591, -46.614}, {168.419, -46.602}, {168.46, -46.572}, {168.565, -46.585}, {168.513, -46.56},
+			{168.426, -46.561}, {168.366, -46.54}, {168.363, -46.578}, {168.406, -46.623}, {168.378, -46.62},
+			{168.274, -46.547}, {168.268, -46.526}, {168.309, -46.52}, {168.326, -46.537}, {168.385, -46.5},
+			{168.394, -46.473}, {168.369, -46.417}, {168.344, -46.417}, {168.341, -46.466},
+			{168.302, -46.478}, {168.216, -46.354}, {168.171, -46.337}, {168.059, -46.354},
+			{168.059, -46.383}, {167.948, -46.37}, {167.922, -46.393}, {167.878, -46.383},
+			{167.839, -46.393}, {167.74, -46.322}, {167.789, -46.294}, {167.713, -46.218},
+			{167.713, -46.198}, {167.59, -46.16}, {167.453, -46.15}, {167.406, -46.251}, {167.295, -46.274},
+			{167.213, -46.26}, {167.175, -46.273}, {167.086, -46.237}, {166.762, -46.22}, {166.671, -46.154},
+			{166.754, -46.102}, {166.832, -46.001}, {166.952, -45.944}, {166.803, -45.993},
+			{166.784, -46.017}, {166.788, -46.04}, {166.751, -46.07}, {166.733, -46.033}, {166.72, -46.064},
+			{166.616, -46.088}, {166.619, -46.055}, {166.644, -46.054}, {166.659, -46}, {166.706, -45.972},
+			{166.792, -45.953}, {166.809, -45.93}, {166.74, -45.95}, {166.691, -45.94}, {166.752, -45.877},
+			{166.744, -45.861}, {166.712, -45.876}, {166.629, -45.965}, {166.534, -45.985},
+			{166.49, -46.013}, {166.475, -45.996}, {166.467, -45.841}, {166.49, -45.808}, {166.624, -45.801},
+			{166.624, -45.815}, {166.678, -45.794}, {166.89, -45.776}, {167.008, -45.719},
+			{167.021, -45.698}, {166.774, -45.719}, {166.805, -45.679}, {166.891, -45.65},
+			{166.778, -45.657}, {166.771, -45.611}, {166.782, -45.594}, {166.912, -45.561},
+			{166.992, -45.575}, {167.013, -45.561}, {166.952, -45.547}, {167.046, -45.517},
+			{167.055, -45.492}, {166.922, -45.541}, {166.785, -45.575}, {166.715, -45.576},
+			{166.702, -45.542}, {166.768, -45.41}, {166.812, -45.393}, {166.952, -45.437}, {166.91, -45.38},
+			{166.898, -45.403}, {166.809, -45.357}, {166.826, -45.317}, {166.891, -45.283},
+			{166.918, -45.313}, {166.959, -45.327}, {166.932, -45.355}, {167.019, -45.341},
+			{167.048, -45.362}, {167.057, -45.39}, {167.011, -45.426}, {167.066, -45.424},
+			{167.097, -45.382}, {167.147, -45.414}, {167.172, -45.471}, {167.206, -45.479}, {167.2, -45.446},
+			{167.048, -45.327}, {167.079, -45.306}, {167.181, -45.285}, {167.259, -45.332},
+			{167.309, -45.313}, {167.244, -45.304}, {167.231, -45.289}, {167.247, -45.253},
+			{167.048, -45.27}, {167.008, -45.194}, {167.012, -45.133}, {167.064, -45.123},
+			{167.127, -45.174}, {167.171, -45.185}, {167.173, -45.171}, {167.101, -45.116},
+			{167.055, -45.102}, {167.119, -45.039}, {167.141, -45.043}, {167.178, -45.088},
+			{167.192, -45.136}, {167.199, -45.094}, {167.275, -45.094}, {167.182, -45.057},
+			{167.152, -45.008}, {167.189, -44.985}, {167.336, -45.037}, {167.35, -45.026},
+			{167.199, -44.964}, {167.27, -44.906}, {167.329, -44.896}, {167.309, -44.875},
+			{167.386, -44.842}, {167.419, -44.841}, {167.45, -44.889}, {167.439, -44.937},
+			{167.466, -44.973}, {167.46, -44.992}, {167.514, -44.993}, {167.477, -44.934},
+			{167.477, -44.893}, {167.448, -44.836}, {167.458, -44.789}, {167.523, -44.764},
+			{167.555, -44.786}, {167.562, -44.799}, {167.537, -44.846}, {167.568, -44.882},
+			{167.595, -44.793}, {167.549, -44.734}, {167.589, -44.716}, {167.679, -44.765},
+			{167.595, -44.698}, {167.619, -44.674}, {167.672, -44.663}, {167.752, -44.603},
+			{167.811, -44.599}, {167.902, -44.653}, {167.933, -44.697}, {167.939, -44.655},
+			{167.857, -44.595}, {167.836, -44.505}, {168.033, -44.353}, {168.028, -44.32},
+			{168.127, -44.317}, {168.14, -44.284}, {168.103, -44.258}, {168.169, -44.238},
+			{168.307, -44.149}, {168.34, -44.118}, {168.353, -44.077}, {168.398, -44.053},
+			{168.371, -44.039}, {168.509, -44.019}, {168.646, -43.965}, {168.699, -44.005},
+			{168.804, -43.997}, {169.065, -43.858}, {169.091, -43.82}, {169.192, -43.759}, {169.38, -43.687},
+			{169.42, -43.651}, {169.483, -43.647}, {169.583, -43.603}, {169.646, -43.608},
+			{169.718, -43.574}, {169.782, -43.515}, {169.789, -43.492}, {169.846, -43.461},
+			{169.875, -43.407}, {170.03, -43.346}, {170.051, -43.303}, {170.088, -43.288},
+			{170.105, -43.258}, {170.178, -43.226}, {170.239, -43.238}, {170.304, -43.162},
+			{170.272, -43.158}, {170.201, -43.217}, {170.22, -43.173}, {170.289, -43.107},
+			{170.385, -43.095}, {170.417, -43.055}, {170.454, -43.053}, {170.457, -43.036},
+			{170.514, -43.007}, {170.643, -42.96}, {170.701, -42.955}, {170.858, -42.843},
+			{170.944, -42.734}, {171.005, -42.706}, {171.123, -42.592}, {171.225, -42.451},
+			{171.235, -42.396}, {171.305, -42.295}, {171.332, -42.147}, {171.364, -42.063},
+			{171.418, -42.006}, {171.489, -41.83}, {171.471, -41.819}, {171.468, -41.766},
+			{171.639, -41.758}, {171.817, -41.668}, {171.855, -41.665}, {171.954, -41.535},
+			{171.994, -41.451}, {172.071, -41.414}, {172.093, -41.299}, {172.14, -41.291},
+			{172.114, -41.251}, {172.107, -40.887}, {172.144, -40.87}, {172.195, -40.8}, {172.364, -40.716},
+			{172.507, -40.589}, {172.544, -40.578}, {172.527, -40.622}, {172.541, -40.627},
+			{172.559, -40.606}, {172.593, -40.604}, {172.594, -40.585}, {172.626, -40.565},
+			{172.611, -40.552}, {172.557, -40.565}, {172.596, -40.528}, {172.641, -40.51}, {172.84, -40.502},
+			{172.997, -40.524}, {172.742, -40.521}, {172.688, -40.62}, {172.697, -40.641}, {172.66, -40.648},
+			{172.701, -40.687}, {172.695, -40.75}, {172.722, -40.737}, {172.736, -40.765},
+			{172.856, -40.844}, {172.924, -40.826}, {172.944, -40.793}, {172.962, -40.805},
+			{172.989, -40.781}, {173.017, -40.797}, {173.023, -40.848}, {173.01, -40.867},
+			{173.054, -40.872}, {173.065, -40.887}, {173.058, -40.963}, {172.997, -41.017},
+			{173.031, -41.017}, {173.069, -41.124}, {173.065, -41.141}, {173.038, -41.113},
+			{173.031, -41.149}, {173.058, -41.189}, {173.076, -41.176}, {173.084, -41.189},
+			{173.106, -41.265}, {173.079, -41.285}, {173.104, -41.308}, {173.213, -41.302},
+			{173.438, -41.137}, {173.455, -41.137}, {173.449, -41.161}, {173.5, -41.141}, {173.538, -41.1},
+			{173.564, -41.104}, {173.562, -41.082}, {173.589, -41.056}, {173.637, -41.069},
+			{173.648, -41.106}, {173.689, -41.1}, {173.675, -41.076}, {173.748, -41.037}, {173.726, -41.017},
+			{173.688, -41.037}, {173.682, -41.016}, {173.74, -40.98}, {173.802, -40.969}, {173.854, -40.926},
+			{173.85, -40.972}, {173.874, -40.976}, {173.914, -40.928}, {173.984, -40.901},
+			{174.024, -40.907}, {174.035, -40.934}, {173.969, -40.928}, {173.976, -40.941},
+			{173.956, -40.969}, {173.914, -40.976}, {173.935, -40.99}, {173.918, -41.002},
+			{173.854, -41.011}, {173.824, -40.998}, {173.781, -41.011}, {173.805, -41.031},
+			{173.781, -41.047}, {173.798, -41.059}, {173.77, -41.1}, {173.779, -41.108}, {173.801, -41.105},
+			{173.829, -41.059}, {173.93, -41.048}, {173.956, -41.059}, {173.936, -41.06}, {173.925, -41.085},
+			{173.883, -41.074}, {173.886, -41.106}, {173.866, -41.106}, {173.859, -41.129},
+			{173.819, -41.155}, {173.852, -41.161}, {173.874, -41.185}, {173.847, -41.241},
+			{173.764, -41.265}, {173.791, -41.291}, {173.935, -41.237}, {173.887, -41.216},
+			{174.006, -41.222}, {174.046, -41.21}, {174.024, -41.195}, {174.083, -41.199},
+			{174.134, -41.175}, {174.021, -41.178}, {173.99, -41.195}, {173.969, -41.189},
+			{173.976, -41.175}, {173.896, -41.192}, {173.893, -41.145}, {173.928, -41.121},
+			{173.949, -41.079}, {173.984, -41.085}, {173.976, -41.147}, {174.015, -41.093},
+			{174.046, -41.093}, {174.024, -41.063}, {174.041, -41.043}, {174.034, -41.017},
+			{174.017, -41.017}, {174, -41.053}, {173.949, -41.011}, {173.984, -41.011}, {173.974, -40.988},
+			{173.997, -40.99}, {173.999, -40.969}, {174.094, -41.019}, {174.128, -40.997},
+			{174.162, -41.004}, {174.168, -40.969}, {174.183, -40.973}, {174.216, -41.014},
+			{174.215, -41.033}, {174.184, -41.043}, {174.207, -41.071}, {174.305, -41.001},
+			{174.326, -41.009}, {174.261, -41.099}, {174.257, -41.127}, {174.207, -41.147}, {174.2, -41.092},
+			{174.182, -41.121}, {174.167, -41.116}, {174.216, -41.189}, {174.155, -41.221},
+			{173.97, -41.249}, {173.935, -41.28}, {174.019, -41.273}, {174.148, -41.237}, {174.199, -41.265},
+			{174.326, -41.216}, {174.245, -41.322}, {174.175, -41.347}, {174.199, -41.309},
+			{174.169, -41.322}, {174.155, -41.319}, {174.162, -41.305}, {174.133, -41.32},
+			{174.051, -41.437}, {174.058, -41.517}, {174.077, -41.52}, {174.104, -41.561}, {174.12, -41.538},
+			{174.103, -41.498}, {174.117, -41.507}, {174.172, -41.578}, {174.226, -41.723},
+			{174.292, -41.73}, {174.286, -41.755}, {174.198, -41.876}, {174.004, -42.031}, {173.961, -42.09},
+			{173.954, -42.159}, {173.891, -42.241}, {173.805, -42.292}, {173.774, -42.33},
+			{173.747, -42.334}, {173.704, -42.379}, {173.723, -42.437}, {173.656, -42.419},
+			{173.559, -42.491}, {173.5, -42.61}, {173.455, -42.627}, {173.477, -42.641}, {173.388, -42.8},
+			{173.323, -42.851}, {173.325, -42.903}, {173.287, -42.954}, {173.154, -43.003},
+			{173.14, -43.032}, {173.083, -43.067}, {172.915, -43.103}, {172.811, -43.169},
+		},
+	},
+	{
+		{
+			{175.169, -36.741}, {175.191, -36.731}, {175.215, -36.758}, {175.182, -36.775},
+			{175.19, -36.815}, {175.183, -36.825}, {175.099, -36.829}, {175.059, -36.817},
+			{175.066, -36.809}, {175.044, -36.793}, {175.034, -36.791}, {175.012, -36.803},
+			{175.01, -36.774}, {175.018, -36.761}, {175.076, -36.761}, {175.106, -36.782},
+		},
+	},
+	{
+		{
+			{175.848, -36.625}, {175.835, -36.629}, {175.822, -36.629}, {175.798, -36.622},
+			{175.795, -36.618}, {175.801, -36.604}, {175.799, -36.597}, {175.777, -36.587},
+			{175.772, -36.577}, {175.799, -36.57}, {175.806, -36.578}, {175.817, -36.604},
+			{175.836, -36.609}, {175.843, -36.615},
+		},
+	},
+	{
+		{
+			{174.853, -36.392}, {174.867, -36.385}, {174.882, -36.39}, {174.896, -36.402},
+			{174.908, -36.439}, {174.906, -36.449}, {174.891, -36.453}, {174.855, -36.452},
+			{174.845, -36.449}, {174.86, -36.44}, {174.861, -36.428}, {174.851, -36.404},
+		},
+	},
+	{
+		{
+			{175.135, -36.176}, {175.155, -36.201}, {175.155, -36.214}, {175.146, -36.222},
+			{175.131, -36.227}, {175.1, -36.228}, {175.081, -36.206}, {175.095, -36.187}, {175.121, -36.175},
+		},
+	},
+	{
+		{
+			{175.538, -36.282}, {175.566, -36.31}, {175.546, -36.343}, {175.523, -36.352},
+			{175.506, -36.309}, {175.467, -36.32}, {175.456, -36.276}, {175.416, -36.276},
+			{175.419, -36.261}, {175.408, -36.248}, {175.385, -36.254}, {175.356, -36.244},
+			{175.334, -36.224}, {175.327, -36.2}, {175.353, -36.225}, {175.37, -36.22}, {175.367, -36.194},
+			{175.379, -36.165}, {175.345, -36.149}, {175.334, -36.132}, {175.364, -36.132},
+			{175.388, -36.118}, {175.368, -36.101}, {175.364, -36.081}, {175.375, -36.066},
+			{175.401, -36.063}, {175.42, -36.072}, {175.434, -36.1}, {175.463, -36.118}, {175.444, -36.126},
+			{175.446, -36.137}, {175.531, -36.176}, {175.511, -36.204}, {175.502, -36.241},
+			{175.519, -36.262}, {175.539, -36.27},
+		},
+	},
+	{
+		{
+			{172.146, -34.145}, {172.157, -34.144}, {172.158, -34.148}, {172.155, -34.152},
+			{172.146, -34.152}, {172.149, -34.162}, {172.148, -34.165}, {172.152, -34.167},
+			{172.145, -34.169}, {172.138, -34.169}, {172.132, -34.166}, {172.116, -34.152},
+			{172.115, -34.146}, {172.116, -34.144}, {172.125, -34.147}, {172.139, -34.149},
+		},
+	},
+	{
+		{
+			{176.954, -39.608}, {177.036, -39.664}, {177.119, -39.667}, {177.083, -39.733},
+			{177.029, -39.765}, {177.006, -39.853}, {176.968, -39.882}, {176.862, -40.141},
+			{176.797, -40.223}, {176.745, -40.257}, {176.718, -40.241}, {176.682, -40.275},
+			{176.669, -40.311}, {176.704, -40.29}, {176.639, -40.391}, {176.628, -40.462},
+			{176.611, -40.485}, {176.542, -40.486}, {176.425, -40.604}, {176.385, -40.678},
+			{176.348, -40.697}, {176.236, -40.914}, {176.15, -40.967}, {176.068, -41.14}, {175.967, -41.247},
+			{175.777, -41.372}, {175.448, -41.552}, {175.38, -41.572}, {175.33, -41.61}, {175.237, -41.62},
+			{175.197, -41.573}, {175.182, -41.524}, {175.197, -41.436}, {175.182, -41.418},
+			{175.035, -41.381}, {174.928, -41.444}, {174.866, -41.42}, {174.877, -41.392},
+			{174.867, -41.333}, {174.901, -41.247}, {174.89, -41.225}, {174.834, -41.223},
+			{174.785, -41.249}, {174.771, -41.278}, {174.832, -41.296}, {174.81, -41.334}, {174.695, -41.34},
+			{174.609, -41.292}, {174.6, -41.257}, {174.612, -41.237}, {174.692, -41.212}, {174.756, -41.159},
+			{174.78, -41.116}, {174.83, -41.088}, {174.853, -41.113}, {174.866, -41.104}, {174.867, -41.072},
+			{174.842, -41.044}, {174.935, -40.983}, {174.979, -40.875}, {175.038, -40.826},
+			{175.141, -40.674}, {175.188, -40.569}, {175.196, -40.472}, {175.223, -40.465},
+			{175.212, -40.419}, {175.239, -40.296}, {175.184, -40.134}, {175.155, -40.085},
+			{174.967, -39.916}, {174.842, -39.862}, {174.735, -39.859}, {174.568, -39.827},
+			{174.437, -39.737}, {174.319, -39.614}, {174.215, -39.579}, {173.987, -39.542},
+			{173.851, -39.464}, {173.784, -39.393}, {173.759, -39.301}, {173.778, -39.208},
+			{173.807, -39.163}, {173.853, -39.134}, {174.212, -38.973}, {174.367, -38.976},
+			{174.415, -38.963}, {174.594, -38.819}, {174.614, -38.715}, {174.635, -38.701},
+			{174.644, -38.463}, {174.707, -38.289}, {174.723, -38.173}, {174.71, -38.105},
+			{174.779, -38.105}, {174.799, -38.139}, {174.805, -38.125}, {174.847, -38.154},
+			{174.867, -38.146}, {174.839, -38.125}, {174.924, -38.118}, {174.943, -38.097},
+			{174.908, -38.105}, {174.904, -38.078}, {174.856, -38.056}, {174.818, -38.088},
+			{174.792, -38.085}, {174.799, -38.043}, {174.887, -37.986}, {174.888, -37.967},
+			{174.853, -37.948}, {174.816, -38.001}, {174.788, -37.985}, {174.792, -37.872},
+			{174.819, -37.825}, {174.977, -37.804}, {174.949, -37.783}, {174.977, -37.75}, {174.97, -37.735},
+			{174.915, -37.776}, {174.867, -37.781}, {174.85, -37.765}, {174.718, -37.412},
+			{174.727, -37.389}, {174.779, -37.372}, {174.812, -37.311}, {174.838, -37.299},
+			{174.823, -37.288}, {174.78, -37.31}, {174.729, -37.363}, {174.706, -37.348}, {174.558, -37.063},
+			{174.643, -37.057}, {174.667, -37.07}, {174.654, -37.097}, {174.74, -37.244}, {174.751, -37.221},
+			{174.736, -37.179}, {174.805, -37.166}, {174.717, -37.153}, {174.786, -37.109},
+			{174.792, -37.133}, {174.856, -37.078}, {174.881, -37.076}, {174.932, -37.118},
+			{174.948, -37.102}, {174.937, -37.066}, {174.851, -37.04}, {174.826, -36.994}, {174.8, -37.018},
+			{174.786, -37.008}, {174.784, -36.986}, {174.833, -36.943}, {174.667, -36.944},
+			{174.641, -36.96}, {174.612, -37.018}, {174.58, -37.001}, {174.544, -37.034}, {174.5, -37.032},
+			{174.408, -36.761}, {174.356, -36.685}, {174.218, -36.545}, {174.188, -36.499},
+			{174.182, -36.453}, {174.21, -36.426}, {174.203, -36.481}, {174.229, -36.444},
+			{174.257, -36.447}, {174.315, -36.528}, {174.356, -36.549}, {174.339, -36.57}, {174.39, -36.583},
+			{174.353, -36.597}, {174.366, -36.611}, {174.36, -36.631}, {174.401, -36.646},
+			{174.401, -36.618}, {174.431, -36.666}, {174.491, -36.577}, {174.47, -36.583},
+			{174.471, -36.519}, {174.45, -36.515}, {174.449, -36.46}, {174.47, -36.44}, {174.441, -36.407},
+			{174.466, -36.373}, {174.428, -36.358}, {174.39, -36.389}, {174.331, -36.393}, {174.278, -36.37},
+			{174.271, -36.338}, {174.285, -36.344}, {174.303, -36.318}, {174.333, -36.33},
+			{174.395, -36.323}, {174.525, -36.241}, {174.511, -36.228}, {174.353, -36.296},
+			{174.305, -36.282}, {174.31, -36.261}, {174.364, -36.282}, {174.421, -36.158}, {174.45, -36.163},
+			{174.442, -36.138}, {174.394, -36.124}, {174.386, -36.189}, {174.333, -36.228},
+			{174.353, -36.207}, {174.322, -36.205}, {174.31, -36.178}, {174.272, -36.173},
+			{174.285, -36.118}, {174.237, -36.145}, {174.241, -36.117}, {174.226, -36.117},
+			{174.182, -36.145}, {174.193, -36.175}, {174.247, -36.187}, {174.251, -36.224},
+			{174.29, -36.211}, {174.319, -36.241}, {174.261, -36.268}, {174.202, -36.243},
+			{174.141, -36.173}, {174.075, -36.168}, {173.998, -36.116}, {173.984, -36.044},
+			{173.901, -35.96}, {173.947, -35.916}, {173.942, -35.891}, {173.908, -35.87}, {173.928, -35.905},
+			{173.922, -35.927}, {173.884, -35.944}, {173.88, -35.96}, {173.959, -36.039}, {174.007, -36.149},
+			{174.041, -36.165}, {174.131, -36.272}, {174.173, -36.277}, {174.182, -36.289},
+			{174.162, -36.297}, {174.203, -36.348}, {174.175, -36.382}, {174.08, -36.4}, {174.024, -36.268},
+			{173.858, -36.066}, {173.407, -35.571}, {173.401, -35.553}, {173.441, -35.5}, {173.458, -35.438},
+			{173.497, -35.418}, {173.542, -35.453}, {173.545, -35.398}, {173.572, -35.433},
+			{173.583, -35.377}, {173.634, -35.363}, {173.661, -35.313}, {173.613, -35.319}, {173.612, -35.3},
+			{173.596, -35.295}, {173.593, -35.337}, {173.565, -35.275}, {173.548, -35.289},
+			{173.572, -35.343}, {173.566, -35.361}, {173.531, -35.369}, {173.495, -35.354},
+			{173.493, -35.383}, {173.464, -35.392}, {173.438, -35.373}, {173.407, -35.391},
+			{173.416, -35.405}, {173.387, -35.425}, {173.391, -35.513}, {173.37, -35.517},
+			{173.272, -35.391}, {173.312, -35.343}, {173.284, -35.343}, {173.318, -35.302},
+			{173.27, -35.322}, {173.249, -35.371}, {173.202, -35.323}, {173.257, -35.281}, {173.178, -35.29},
+			{173.093, -35.207}, {173.11, -35.182}, {173.147, -35.193}, {173.198, -35.109},
+			{173.202, -35.058}, {173.157, -34.945}, {173.065, -34.857}, {173.034, -34.791},
+			{172.799, -34.551}, {172.674, -34.48}, {172.701, -34.446}, {172.703, -34.412},
+			{172.825, -34.446}, {172.9, -34.412}, {173.013, -34.421}, {173.064, -34.398}, {173.079, -34.412},
+			{173.043, -34.447}, {173.038, -34.522}, {173.014, -34.528}, {172.983, -34.494},
+			{172.99, -34.472}, {172.97, -34.467}, {172.952, -34.516}, {172.928, -34.513}, {172.907, -34.541},
+			{172.949, -34.57}, {172.976, -34.541}, {172.962, -34.57}, {172.973, -34.576}, {172.997, -34.562},
+			{173.007, -34.651}, {173.031, -34.592}, {173.01, -34.541}, {173.036, -34.552},
+			{173.072, -34.699}, {173.179, -34.794}, {173.189, -34.836}, {173.119, -34.774},
+			{173.123, -34.81}, {173.298, -34.909}, {173.264, -34.961}, {173.267, -35.008},
+			{173.325, -35.015}, {173.369, -34.967}, {173.358, -34.869}, {173.425, -34.846},
+			{173.425, -34.805}, {173.444, -34.796}, {173.449, -34.85}, {173.49, -34.836}, {173.503, -34.87},
+			{173.42, -34.884}, {173.404, -34.903}, {173.459, -34.981}, {173.549, -34.989},
+			{173.586, -35.015}, {173.6, -34.994}, {173.565, -34.945}, {173.588, -34.928}, {173.613, -34.953},
+			{173.65, -34.946}, {173.675, -34.973}, {173.732, -34.962}, {173.748, -34.999},
+			{173.784, -35.008}, {173.724, -35.064}, {173.76, -35.097}, {173.786, -35.092},
+			{173.805, -35.015}, {173.891, -35.001}, {173.97, -35.076}, {173.99, -35.119}, {174.079, -35.117},
+			{174.128, -35.136}, {174.155, -35.182}, {174.113, -35.193}, {174.094, -35.159},
+			{174.07, -35.165}, {174.058, -35.152}, {174.024, -35.169}, {174.052, -35.199},
+			{174.018, -35.203}, {174.015, -35.217}, {174.094, -35.22}, {174.106, -35.236},
+			{174.094, -35.275}, {174.143, -35.328}, {174.151, -35.37}, {174.168, -35.329}, {174.21, -35.343},
+			{174.203, -35.323}, {174.24, -35.337}, {174.252, -35.323}, {174.155, -35.275},
+			{174.277, -35.268}, {174.353, -35.199}, {174.361, -35.224}, {174.333, -35.288},
+			{174.34, -35.312}, {174.401, -35.323}, {174.392, -35.343}, {174.422, -35.374},
+			{174.408, -35.384}, {174.333, -35.329}, {174.386, -35.419}, {174.456, -35.433},
+			{174.475, -35.454}, {174.504, -35.501}, {174.464, -35.506}, {174.469, -35.518},
+			{174.57, -35.594}, {174.578, -35.618}, {174.565, -35.652}, {174.477, -35.643},
+			{174.531, -35.672}, {174.511, -35.679}, {174.547, -35.688}, {174.548, -35.707},
+			{174.517, -35.721}, {174.586, -35.761}, {174.581, -35.797}, {174.607, -35.844},
+			{174.559, -35.839}, {174.517, -35.81}, {174.524, -35.791}, {174.496, -35.785},
+			{174.497, -35.768}, {174.442, -35.782}, {174.418, -35.768}, {174.386, -35.789},
+			{174.384, -35.747}, {174.36, -35.713}, {174.353, -35.842}, {174.375, -35.852},
+			{174.416, -35.817}, {174.504, -35.83}, {174.52, -35.847}, {174.516, -35.867}, {174.477, -35.905},
+			{174.493, -35.919}, {174.497, -35.988}, {174.554, -36.027}, {174.621, -36.042},
+			{174.626, -36.107}, {174.757, -36.245}, {174.824, -36.277}, {174.823, -36.306},
+			{174.801, -36.306}, {174.806, -36.335}, {174.881, -36.351}, {174.751, -36.354},
+			{174.783, -36.436}, {174.779, -36.487}, {174.743, -36.42}, {174.717, -36.412},
+			{174.735, -36.456}, {174.733, -36.473}, {174.71, -36.481}, {174.744, -36.501}, {174.71, -36.529},
+			{174.723, -36.594}, {174.764, -36.616}, {174.857, -36.611}, {174.782, -36.646},
+			{174.723, -36.631}, {174.759, -36.673}, {174.819, -36.824}, {174.77, -36.805},
+			{174.744, -36.817}, {174.704, -36.758}, {174.621, -36.796}, {174.683, -36.803},
+			{174.649, -36.826}, {174.675, -36.845}, {174.68, -36.907}, {174.701, -36.908},
+			{174.703, -36.871}, {174.751, -36.845}, {174.876, -36.851}, {174.897, -36.862},
+			{174.894, -36.893}, {174.869, -36.92}, {174.891, -36.958}, {174.935, -36.837},
+			{174.963, -36.934}, {175.024, -36.92}, {175.004, -36.879}, {175.027, -36.875}, {175.08, -36.885},
+			{175.093, -36.97}, {175.241, -36.942}, {175.326, -37.009}, {175.325, -37.152},
+			{175.367, -37.207}, {175.448, -37.225}, {175.563, -37.18}, {175.579, -37.237}, {175.61, -37.252},
+			{175.628, -37.242}, {175.596, -37.213}, {175.56, -37.097}, {175.561, -37.045},
+			{175.528, -36.962}, {175.436, -36.865}, {175.503, -36.862}, {175.518, -36.845},
+			{175.456, -36.837}, {175.498, -36.817}, {175.47, -36.809}, {175.537, -36.786},
+			{175.532, -36.761}, {175.511, -36.775}, {175.504, -36.766}, {175.505, -36.735},
+			{175.482, -36.705}, {175.498, -36.631}, {175.387, -36.572}, {175.357, -36.53},
+			{175.362, -36.477}, {175.44, -36.475}, {175.496, -36.53}, {175.532, -36.508}, {175.562, -36.552},
+			{175.56, -36.577}, {175.538, -36.597}, {175.6, -36.616}, {175.607, -36.642}, {175.607, -36.681},
+			{175.584, -36.668}, {175.571, -36.678}, {175.634, -36.705}, {175.621, -36.735},
+			{175.628, -36.764}, {175.682, -36.741}, {175.662, -36.735}, {175.682, -36.722},
+			{175.719, -36.736}, {175.776, -36.708}, {175.828, -36.726}, {175.852, -36.718},
+			{175.848, -36.748}, {175.81, -36.749}, {175.786, -36.783}, {175.718, -36.824},
+			{175.704, -36.845}, {175.714, -36.875}, {175.745, -36.89}, {175.765, -36.824},
+			{175.828, -36.826}, {175.851, -36.841}, {175.888, -36.974}, {175.887, -37.021},
+			{175.868, -37.053}, {175.902, -37.036}, {175.92, -37.055}, {175.884, -37.137},
+			{175.916, -37.146}, {175.923, -37.2}, {175.888, -37.166}, {175.901, -37.231}, {175.888, -37.242},
+			{175.943, -37.282}, {175.961, -37.377}, {176.005, -37.447}, {176.004, -37.461},
+			{175.984, -37.454}, {175.975, -37.47}, {176.077, -37.507}, {176.169, -37.625},
+			{176.121, -37.598}, {176.076, -37.596}, {176.059, -37.584}, {176.101, -37.57},
+			{176.035, -37.526}, {175.99, -37.515}, {175.943, -37.522}, {175.963, -37.543}, {175.957, -37.57},
+			{175.997, -37.584}, {175.997, -37.632}, {176.063, -37.632}, {176.087, -37.673},
+			{176.103, -37.665}, {176.131, -37.687}, {176.157, -37.663}, {176.177, -37.666},
+			{176.196, -37.682}, {176.202, -37.72}, {176.214, -37.719}, {176.245, -37.714},
+			{176.203, -37.622}, {176.217, -37.618}, {176.272, -37.683}, {176.461, -37.755},
+			{176.519, -37.762}, {176.718, -37.878}, {176.95, -37.928}, {177.005, -37.955},
+			{177.098, -37.965}, {177.136, -37.982}, {177.066, -37.985}, {177.131, -38.022},
+			{177.158, -38.018}, {177.149, -37.982}, {177.262, -37.996}, {177.282, -38.016},
+			{177.307, -37.989}, {177.438, -37.977}, {177.557, -37.914}, {177.598, -37.882},
+			{177.622, -37.814}, {177.685, -37.772}, {177.739, -37.676}, {177.877, -37.648},
+			{177.914, -37.608}, {177.992, -37.591}, {178.009, -37.57}, {177.992, -37.543},
+			{178.278, -37.559}, {178.313, -37.573}, {178.3, -37.591}, {178.341, -37.618}, {178.498, -37.647},
+			{178.551, -37.681}, {178.564, -37.718}, {178.499, -37.783}, {178.465, -37.854},
+			{178.429, -37.874}, {178.348, -38.013}, {178.381, -38.079}, {178.376, -38.105},
+			{178.33, -38.146}, {178.355, -38.188}, {178.319, -38.266}, {178.362, -38.317}, {178.32, -38.399},
+			{178.343, -38.41}, {178.348, -38.434}, {178.299, -38.467}, {178.3, -38.532}, {178.08, -38.709},
+			{178.062, -38.716}, {177.997, -38.683}, {177.932, -38.702}, {177.937, -38.735},
+			{177.964, -38.761}, {177.938, -38.82}, {177.903, -39.017}, {177.911, -39.036},
+			{177.883, -39.086}, {177.952, -39.091}, {177.985, -39.105}, {177.999, -39.13},
+			{177.944, -39.174}, {177.912, -39.256}, {177.869, -39.29}, {177.841, -39.229},
+			{177.834, -39.167}, {177.865, -39.109}, {177.852, -39.089}, {177.82, -39.106},
+			{177.764, -39.081}, {177.444, -39.072}, {177.413, -39.059}, {177.065, -39.199},
+			{177.025, -39.275}, {176.938, -39.349}, {176.903, -39.431}, {176.945, -39.495},
+		},
+	},
+})
+
+// chathamIslands are the Chatham Islands.
+var chathamIslands = newBoundary(MultiPolygon{
+	{
+		{
+			{-176.145, -44.248}, {-176.114, -44.265}, {-176.149, -44.282}, {-176.159, -44.295},
+			{-176.162, -44.317}, {-176.219, -44.341}, {-176.218, -44.327}, {-176.231, -44.313},
+			{-176.227, -44.31}, {-176.231, -44.307}, {-176.211, -44.265}, {-176.236, -44.242},
+			{-176.224, -44.231}, {-176.209, -44.225}, {-176.17, -44.221}, {-176.155, -44.224},
+			{-176.15, -44.229},
+		},
+	},
+	{
+		{
+			{-176.382, -43.899}, {-176.399, -43.908}, {-176.409, -43.854}, {-176.358, -43.805},
+			{-176.354, -43.786}, {-176.372, -43.766}, {-176.535, -43.756}, {-176.514, -43.791},
+			{-176.423, -43.806}, {-176.502, -43.864}, {-176.46, -43.912}, {-176.457, -43.95},
+			{-176.433, -43.963}, {-176.395, -43.939}, {-176.382, -43.986}, {-176.313, -44.019},
+			{-176.3, -44.043}, {-176.435, -44.07}, {-176.505, -44.101}, {-176.512, -44.122},
+			{-176.581, -44.123}, {-176.608, -44.112}, {-176.618, -44.071}, {-176.664, -44.019},
+			{-176.516, -43.922}, {-176.566, -43.838}, {-176.638, -43.8}, {-176.683, -43.806},
+			{-176.685, -43.827}, {-176.725, -43.834}, {-176.754, -43.824}, {-176.826, -43.84},
+			{-176.855, -43.82}, {-176.779, -43.779}, {-176.786, -43.756}, {-176.735, -43.772},
+			{-176.645, -43.764}, {-176.625, -43.755}, {-176.602, -43.708}, {-176.581, -43.703},
+			{-176.392, -43.745}, {-176.169, -43.731}, {-176.196, -43.744}, {-176.203, -43.772},
+			{-176.244, -43.754}, {-176.285, -43.77}, {-176.37, -43.863},
+		},
+	},
+})
+
+// newZealandChatham is New Zealand with the Chatham Islands.
+var newZealandChatham = newBoundary(append(append(MultiPolygon{}, newZealand.polygons...), chathamIslands.polygons...))
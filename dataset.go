@@ -0,0 +1,723 @@
+//nolint:varnamelen,gomnd,nonamedreturns
+package wgs84
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrEPSGDataset is returned for missing or unreadable tables of the EPSG
+// dataset.
+var ErrEPSGDataset = errors.New("invalid epsg dataset")
+
+// UnsupportedCode is a code of the EPSG dataset that couldn't be imported.
+type UnsupportedCode struct {
+	Code   int
+	Name   string
+	Reason string
+}
+
+// LoadEPSG returns the EPSG Repository extended by the EPSG dataset in a
+// local directory. See Repository.Import.
+func LoadEPSG(dir string) (*Repository, []UnsupportedCode, error) {
+	r := EPSG()
+
+	unsupported, err := r.Import(os.DirFS(dir))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r, unsupported, nil
+}
+
+// Import adds the geographic, geocentric and projected Coordinate Reference
+// Systems of the EPSG dataset to the Repository. Existing codes are kept.
+//
+// The dataset is read from CSV exports of its tables with a header row of
+// the column names. The files are named by the tables, for example
+// epsg_coordinatereferencesystem.csv or coordinatereferencesystem.csv.
+//
+// Required are the tables coordinatereferencesystem, datum, ellipsoid,
+// coordoperation, coordoperationparamvalue and unitofmeasure. The optional
+// tables coordinateaxis and area or extent and usage are used for the units
+// and orientations of the axes and the areas of use. The names of the Coordinate Reference
+// Systems and the optional table alias are added as names and aliases.
+//
+// Datums are transformed to WGS84 by the most accurate transformation to
+// https://epsg.io/4326 with a supported method. All transformations between
+// datums with a supported method are added as Operations for Plan, existing
+// Operations are kept. Deprecated codes are skipped.
+// All other codes that can't be imported are returned with a reason.
+func (r *Repository) Import(fsys fs.FS) ([]UnsupportedCode, error) {
+	d, err := readDataset(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	var unsupported []UnsupportedCode
+
+	for _, code := range d.crsCodes() {
+		if r.Code(code) != nil {
+			continue
+		}
+
+		crs, err := d.crs(code)
+		if err != nil {
+			unsupported = append(unsupported, UnsupportedCode{
+				Code:   code,
+				Name:   d.crss[code]["coord_ref_sys_name"],
+				Reason: err.Error(),
+			})
+
+			continue
+		}
+
+		r.Add(code, crs)
+		r.AddName(code, d.crss[code]["coord_ref_sys_name"], d.aliases[code]...)
+	}
+
+	for _, op := range d.transformations {
+		if _, ok := r.Operation(op.int("coord_op_code")); ok {
+			continue
+		}
+
+		if operation, err := d.operation(op); err == nil {
+			r.AddOperation(operation)
+		}
+	}
+
+	return unsupported, nil
+}
+
+type row map[string]string
+
+func (r row) float(column string) (float64, bool) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(r[column]), 64)
+
+	return v, err == nil
+}
+
+func (r row) int(column string) int {
+	v, _ := strconv.Atoi(strings.TrimSpace(r[column]))
+
+	return v
+}
+
+func (r row) deprecated() bool {
+	v := strings.ToLower(strings.TrimSpace(r["deprecated"]))
+
+	return v == "1" || v == "true" || v == "yes"
+}
+
+type dataset struct {
+	crss, datums, ellipsoids, units, operations, areas map[int]row
+	params                                             map[int][]row
+	axes                                               map[int][]row
+	usages, operationUsages                            map[int]int
+	aliases                                            map[int][]string
+	toWGS84                                            map[int][]row // by datum
+	transformations                                    []row
+}
+
+func readDataset(fsys fs.FS) (*dataset, error) {
+	d := &dataset{
+		params:          map[int][]row{},
+		axes:            map[int][]row{},
+		usages:          map[int]int{},
+		operationUsages: map[int]int{},
+		aliases:         map[int][]string{},
+		toWGS84:         map[int][]row{},
+	}
+
+	for _, t := range []struct {
+		name, key string
+		table     *map[int]row
+		optional  bool
+	}{
+		{"coordinatereferencesystem", "coord_ref_sys_code", &d.crss, false},
+		{"datum", "datum_code", &d.datums, false},
+		{"ellipsoid", "ellipsoid_code", &d.ellipsoids, false},
+		{"unitofmeasure", "uom_code", &d.units, false},
+		{"coordoperation", "coord_op_code", &d.operations, false},
+		{"area", "area_code", &d.areas, true},
+	} {
+		rows, err := readTable(fsys, t.name, t.optional)
+		if err != nil {
+			return nil, err
+		}
+
+		*t.table = map[int]row{}
+
+		for _, r := range rows {
+			(*t.table)[r.int(t.key)] = r
+		}
+	}
+
+	params, err := readTable(fsys, "coordoperationparamvalue", false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range params {
+		d.params[r.int("coord_op_code")] = append(d.params[r.int("coord_op_code")], r)
+	}
+
+	axes, err := readTable(fsys, "coordinateaxis", true)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range axes {
+		d.axes[r.int("coord_sys_code")] = append(d.axes[r.int("coord_sys_code")], r)
+	}
+
+	// EPSG dataset version 10 stores the areas of use as extents and usages.
+	extents, err := readTable(fsys, "extent", true)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range extents {
+		d.areas[r.int("extent_code")] = row{
+			"area_south_bound_lat": r["bbox_south_bound_lat"],
+			"area_north_bound_lat": r["bbox_north_bound_lat"],
+			"area_west_bound_lon":  r["bbox_west_bound_lon"],
+			"area_east_bound_lon":  r["bbox_east_bound_lon"],
+		}
+	}
+
+	usages, err := readTable(fsys, "usage", true)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range usages {
+		usages := map[string]map[int]int{
+			"epsg_coordinatereferencesystem": d.usages,
+			"epsg_coordoperation":            d.operationUsages,
+		}[strings.ToLower(r["object_table_name"])]
+
+		if _, ok := usages[r.int("object_code")]; usages != nil && !ok {
+			usages[r.int("object_code")] = r.int("extent_code")
+		}
+	}
+
+	aliases, err := readTable(fsys, "alias", true)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range aliases {
+		if strings.EqualFold(r["object_table_name"], "epsg_coordinatereferencesystem") && r["alias"] != "" {
+			d.aliases[r.int("object_code")] = append(d.aliases[r.int("object_code")], r["alias"])
+		}
+	}
+
+	for _, op := range d.operations {
+		if op.deprecated() || !strings.EqualFold(op["coord_op_type"], "transformation") {
+			continue
+		}
+
+		source := d.crss[op.int("source_crs_code")].int("datum_code")
+		target := d.crss[op.int("target_crs_code")].int("datum_code")
+
+		if op.int("target_crs_code") == 4326 {
+			d.toWGS84[source] = append(d.toWGS84[source], op)
+		}
+
+		if source != 0 && target != 0 && source != target {
+			d.transformations = append(d.transformations, op)
+		}
+	}
+
+	sort.Slice(d.transformations, func(i, j int) bool {
+		return d.transformations[i].int("coord_op_code") < d.transformations[j].int("coord_op_code")
+	})
+
+	return d, nil
+}
+
+func readTable(fsys fs.FS, name string, optional bool) ([]row, error) {
+	var (
+		f   fs.File
+		err error
+	)
+
+	for _, file := range []string{"epsg_" + name + ".csv", name + ".csv", "EPSG_" + strings.ToUpper(name) + ".csv"} {
+		f, err = fsys.Open(file)
+		if err == nil {
+			break
+		}
+	}
+
+	if err != nil {
+		if optional {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("%w: missing table %s", ErrEPSGDataset, name)
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: table %s: %v", ErrEPSGDataset, name, err)
+	}
+
+	for i := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff")))
+	}
+
+	var rows []row
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: table %s: %v", ErrEPSGDataset, name, err)
+		}
+
+		r := make(row, len(header))
+
+		for i, v := range record {
+			if i < len(header) {
+				r[header[i]] = v
+			}
+		}
+
+		rows = append(rows, r)
+	}
+
+	return rows, nil
+}
+
+func (d *dataset) crsCodes() []int {
+	codes := make([]int, 0, len(d.crss))
+
+	for code, crs := range d.crss {
+		if !crs.deprecated() {
+			codes = append(codes, code)
+		}
+	}
+
+	sort.Ints(codes)
+
+	return codes
+}
+
+func (d *dataset) crs(code int) (CoordinateReferenceSystem, error) {
+	crs := d.crss[code]
+	kind := strings.ToLower(crs["coord_ref_sys_kind"])
+
+	switch kind {
+	case "geographic 2d", "geographic 3d", "geocentric":
+		datum, err := d.datum(code)
+		if err != nil {
+			return nil, err
+		}
+
+		if kind == "geocentric" {
+			return datum.XYZ(), nil
+		}
+
+		if err := d.checkAxes(crs, 9102, 9122); err != nil {
+			return nil, err
+		}
+
+		return datum.LonLat(), nil
+	case "projected":
+		return d.projected(code)
+	}
+
+	return nil, fmt.Errorf("unsupported kind %s", kind)
+}
+
+func (d *dataset) projected(code int) (CoordinateReferenceSystem, error) {
+	crs := d.crss[code]
+
+	if err := d.checkAxes(crs, 9001); err != nil {
+		return nil, err
+	}
+
+	base := crs.int("base_crs_code")
+	if _, ok := d.crss[base]; !ok {
+		return nil, fmt.Errorf("unknown base crs %d", base)
+	}
+
+	datum, err := d.datum(base)
+	if err != nil {
+		return nil, err
+	}
+
+	conversion := crs.int("projection_conv_code")
+	if _, ok := d.operations[conversion]; !ok {
+		return nil, fmt.Errorf("unknown conversion %d", conversion)
+	}
+
+	p, err := d.parameters(conversion)
+	if err != nil {
+		return nil, err
+	}
+
+	var proj ProjectedReferenceSystem
+
+	switch method := d.operations[conversion].int("coord_op_method_code"); method {
+	case 9807:
+		proj = datum.TransverseMercator(p.deg(8802), p.deg(8801), p.unity(8805, 1), p.m(8806), p.m(8807))
+	case 9802:
+		proj = datum.LambertConformalConic2SP(p.deg(8822), p.deg(8821), p.deg(8823), p.deg(8824), p.m(8826), p.m(8827))
+	case 9822:
+		proj = datum.AlbersEqualAreaConic(p.deg(8822), p.deg(8821), p.deg(8823), p.deg(8824), p.m(8826), p.m(8827))
+	case 9820:
+		proj = datum.LambertAzimuthalEqualArea(p.deg(8802), p.deg(8801), p.m(8806), p.m(8807))
+	case 1024:
+		proj = datum.WebMercator()
+	case 9811:
+		proj = datum.NewZealandMapGrid()
+	default:
+		return nil, fmt.Errorf("unsupported projection method %d", method)
+	}
+
+	proj.Area = d.area(code)
+
+	return proj, nil
+}
+
+// checkAxes checks the orientations and units of the coordinate axes. East
+// and north axes are supported in one of the units and ellipsoidal heights
+// in metres.
+func (d *dataset) checkAxes(crs row, units ...int) error {
+	for _, axis := range d.axes[crs.int("coord_sys_code")] {
+		unit := axis.int("uom_code")
+		supported := false
+
+		switch orientation := strings.ToLower(strings.TrimSpace(axis["coord_axis_orientation"])); orientation {
+		case "east", "north":
+			for _, u := range units {
+				supported = supported || unit == u
+			}
+		case "up":
+			supported = unit == 9001
+		default:
+			return fmt.Errorf("unsupported axis orientation %s", orientation)
+		}
+
+		if !supported {
+			return fmt.Errorf("unsupported axis unit %d", unit)
+		}
+	}
+
+	return nil
+}
+
+// datum returns the Datum of a geographic or geocentric crs.
+func (d *dataset) datum(code int) (Datum, error) {
+	datumCode := d.crss[code].int("datum_code")
+
+	datum, ok := d.datums[datumCode]
+	if !ok {
+		return Datum{}, fmt.Errorf("unknown datum %d", datumCode)
+	}
+
+	if pm := datum.int("prime_meridian_code"); pm != 0 && pm != 8901 {
+		return Datum{}, fmt.Errorf("unsupported prime meridian %d", pm)
+	}
+
+	ellipsoid, ok := d.ellipsoids[datum.int("ellipsoid_code")]
+	if !ok {
+		return Datum{}, fmt.Errorf("unknown ellipsoid %d", datum.int("ellipsoid_code"))
+	}
+
+	a, fi, err := d.spheroid(ellipsoid)
+	if err != nil {
+		return Datum{}, err
+	}
+
+	area := d.area(code)
+
+	if datumCode == 6326 {
+		return Datum{Code: datumCode, Spheroid: spheroid{a: a, fi: fi}, Area: area}, nil
+	}
+
+	t, err := d.transformation(datumCode, a, fi)
+	if err != nil {
+		return Datum{}, err
+	}
+
+	t.Code, t.Area = datumCode, area
+
+	return t, nil
+}
+
+func (d *dataset) spheroid(ellipsoid row) (a, fi float64, err error) {
+	a, ok := ellipsoid.float("semi_major_axis")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid ellipsoid %d", ellipsoid.int("ellipsoid_code"))
+	}
+
+	factor, err := d.factor(ellipsoid.int("uom_code"))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	a *= factor
+
+	if fi, ok := ellipsoid.float("inv_flattening"); ok && fi != 0 {
+		return a, fi, nil
+	}
+
+	b, ok := ellipsoid.float("semi_minor_axis")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid ellipsoid %d", ellipsoid.int("ellipsoid_code"))
+	}
+
+	if b *= factor; b == a {
+		return a, math.Inf(1), nil
+	}
+
+	return a, a / (a - b), nil
+}
+
+// transformation returns a Datum with the most accurate supported
+// transformation to WGS84.
+func (d *dataset) transformation(datum int, a, fi float64) (Datum, error) {
+	ops := d.toWGS84[datum]
+	if len(ops) == 0 {
+		return Datum{}, fmt.Errorf("no transformation to 4326")
+	}
+
+	sort.SliceStable(ops, func(i, j int) bool {
+		ai, ok := ops[i].float("coord_op_accuracy")
+		if !ok {
+			ai = math.Inf(1)
+		}
+
+		aj, ok := ops[j].float("coord_op_accuracy")
+		if !ok {
+			aj = math.Inf(1)
+		}
+
+		return ai < aj
+	})
+
+	var reasons []string
+
+	for _, op := range ops {
+		datum, err := d.datumTransformation(op, a, fi)
+		if err == nil {
+			return datum, nil
+		}
+
+		reasons = append(reasons, err.Error())
+	}
+
+	return Datum{}, errors.New(strings.Join(reasons, ", "))
+}
+
+func (d *dataset) datumTransformation(op row, a, fi float64) (Datum, error) {
+	p, err := d.parameters(op.int("coord_op_code"))
+	if err != nil {
+		return Datum{}, err
+	}
+
+	tx, ty, tz := p.m(8605), p.m(8606), p.m(8607)
+	rx, ry, rz, ds := p.arcsec(8608), p.arcsec(8609), p.arcsec(8610), p.ppm(8611)
+
+	switch method := op.int("coord_op_method_code"); method {
+	case 9603, 1031, 1035:
+		return Helmert(a, fi, tx, ty, tz, 0, 0, 0, 0), nil
+	case 9606, 1033, 1037:
+		return Helmert(a, fi, tx, ty, tz, rx, ry, rz, ds), nil
+	case 9607, 1032, 1038:
+		return Helmert(a, fi, tx, ty, tz, -rx, -ry, -rz, ds), nil
+	case 9604:
+		return Molodensky(a, fi, tx, ty, tz), nil
+	case 9605:
+		return AbridgedMolodensky(a, fi, tx, ty, tz), nil
+	case 9636, 1034, 1039:
+		return MolodenskyBadekas(a, fi, tx, ty, tz, -rx, -ry, -rz, ds, p.m(8617), p.m(8618), p.m(8667)), nil
+	case 1061, 1062, 1063:
+		return MolodenskyBadekas(a, fi, tx, ty, tz, rx, ry, rz, ds, p.m(8617), p.m(8618), p.m(8667)), nil
+	default:
+		return Datum{}, fmt.Errorf("unsupported transformation method %d", method)
+	}
+}
+
+// operation returns a transformation between two datums as an Operation.
+// Molodensky-Transformations are only supported to WGS84.
+func (d *dataset) operation(op row) (Operation, error) {
+	source := d.crss[op.int("source_crs_code")].int("datum_code")
+	target := d.crss[op.int("target_crs_code")].int("datum_code")
+
+	if method := op.int("coord_op_method_code"); (method == 9604 || method == 9605) && target != 6326 {
+		return Operation{}, fmt.Errorf("unsupported transformation method %d", method)
+	}
+
+	datum, ok := d.datums[source]
+	if !ok {
+		return Operation{}, fmt.Errorf("unknown datum %d", source)
+	}
+
+	a, fi, err := d.spheroid(d.ellipsoids[datum.int("ellipsoid_code")])
+	if err != nil {
+		return Operation{}, err
+	}
+
+	t, err := d.datumTransformation(op, a, fi)
+	if err != nil {
+		return Operation{}, err
+	}
+
+	accuracy, ok := op.float("coord_op_accuracy")
+	if !ok {
+		accuracy = -1
+	}
+
+	areaCode := op.int("area_of_use_code")
+	if extent, ok := d.operationUsages[op.int("coord_op_code")]; ok {
+		areaCode = extent
+	}
+
+	return Operation{
+		Code:           op.int("coord_op_code"),
+		Name:           op["coord_op_name"],
+		Source:         source,
+		Target:         target,
+		Accuracy:       accuracy,
+		Area:           d.boundingBox(areaCode),
+		Transformation: t.Transformation,
+	}, nil
+}
+
+func (d *dataset) area(code int) Area {
+	areaCode := d.crss[code].int("area_of_use_code")
+	if extent, ok := d.usages[code]; ok {
+		areaCode = extent
+	}
+
+	return d.boundingBox(areaCode)
+}
+
+func (d *dataset) boundingBox(areaCode int) BoundingBox {
+	area, ok := d.areas[areaCode]
+	if !ok {
+		return BoundingBox{West: -180, South: -90, East: 180, North: 90}
+	}
+
+	south, _ := area.float("area_south_bound_lat")
+	north, _ := area.float("area_north_bound_lat")
+	west, _ := area.float("area_west_bound_lon")
+	east, _ := area.float("area_east_bound_lon")
+
+	return BoundingBox{West: west, South: south, East: east, North: north}
+}
+
+// factor returns the factor of a unit to meters, radians or unity.
+func (d *dataset) factor(uom int) (float64, error) {
+	unit, ok := d.units[uom]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit %d", uom)
+	}
+
+	b, ok1 := unit.float("factor_b")
+	c, ok2 := unit.float("factor_c")
+
+	if !ok1 || !ok2 || c == 0 {
+		return 0, fmt.Errorf("unsupported unit %d", uom)
+	}
+
+	return b / c, nil
+}
+
+type parameters map[int]float64
+
+// parameters returns the parameter values in meters, radians or unity.
+func (d *dataset) parameters(op int) (parameters, error) {
+	p := parameters{}
+
+	for _, v := range d.params[op] {
+		value, ok := v.float("parameter_value")
+		if !ok {
+			if strings.TrimSpace(v["param_value_file_ref"]) != "" {
+				return nil, fmt.Errorf("unsupported parameter file %s", v["param_value_file_ref"])
+			}
+
+			continue
+		}
+
+		uom := v.int("uom_code")
+
+		switch uom {
+		case 9110:
+			value = radian(sexagesimal(value))
+		default:
+			factor, err := d.factor(uom)
+			if err != nil {
+				return nil, err
+			}
+
+			value *= factor
+		}
+
+		p[v.int("parameter_code")] = value
+	}
+
+	return p, nil
+}
+
+func (p parameters) m(code int) float64 {
+	return p[code]
+}
+
+func (p parameters) deg(code int) float64 {
+	return degree(p[code])
+}
+
+func (p parameters) arcsec(code int) float64 {
+	return degree(p[code]) * 3600
+}
+
+func (p parameters) ppm(code int) float64 {
+	return p[code] * 1e6
+}
+
+func (p parameters) unity(code int, def float64) float64 {
+	if v, ok := p[code]; ok {
+		return v
+	}
+
+	return def
+}
+
+// sexagesimal converts an angle in the format DDD.MMSSsss to degrees.
+func sexagesimal(v float64) float64 {
+	sign := 1.0
+	if v < 0 {
+		sign, v = -1, -v
+	}
+
+	s := strconv.FormatFloat(v, 'f', 10, 64)
+	deg, frac, _ := strings.Cut(s, ".")
+	frac += "0000"
+
+	d, _ := strconv.ParseFloat(deg, 64)
+	m, _ := strconv.ParseFloat(frac[:2], 64)
+	sec, _ := strconv.ParseFloat(frac[2:4]+"."+frac[4:], 64)
+
+	return sign * (d + m/60 + sec/3600)
+}
@@ -0,0 +1,187 @@
+//nolint:varnamelen
+package wgs84_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/wroge/wgs84"
+)
+
+//nolint:gochecknoglobals
+var dataset = fstest.MapFS{
+	"epsg_unitofmeasure.csv": {Data: []byte(`uom_code,unit_of_meas_name,unit_of_meas_type,factor_b,factor_c
+9001,metre,length,1,1
+9003,US survey foot,length,12,39.37
+9102,degree,angle,3.14159265358979,180
+9104,arc-second,angle,3.14159265358979,648000
+9110,sexagesimal DMS,angle,,
+9122,degree (supplier to define representation),angle,3.14159265358979,180
+9201,unity,scale,1,1
+9202,parts per million,scale,1,1000000
+`)},
+	"epsg_ellipsoid.csv": {Data: []byte(`ellipsoid_code,ellipsoid_name,semi_major_axis,uom_code,inv_flattening,semi_minor_axis
+7004,Bessel 1841,6377397.155,9001,299.1528128,
+7030,WGS 84,6378137,9001,298.257223563,
+7019,GRS 1980,6378137,9001,,6356752.314140347
+`)},
+	"epsg_datum.csv": {Data: []byte(`datum_code,datum_name,datum_type,ellipsoid_code,prime_meridian_code
+6314,Deutsches Hauptdreiecksnetz,geodetic,7004,8901
+6326,World Geodetic System 1984,geodetic,7030,8901
+6807,Nouvelle Triangulation Francaise (Paris),geodetic,7019,8903
+`)},
+	"epsg_area.csv": {Data: []byte(`area_code,area_name,area_south_bound_lat,area_north_bound_lat,area_west_bound_lon,area_east_bound_lon
+2326,Germany,47.27,55.09,5.87,13.84
+1262,World,-90,90,-180,180
+`)},
+	"epsg_coordinatereferencesystem.csv": {Data: []byte(`coord_ref_sys_code,coord_ref_sys_name,area_of_use_code,coord_ref_sys_kind,coord_sys_code,datum_code,base_crs_code,projection_conv_code,deprecated
+4314,DHDN,2326,geographic 2D,6422,6314,,,0
+4326,WGS 84,1262,geographic 2D,6422,6326,,,0
+4979,WGS 84,1262,geographic 3D,6423,6326,,,0
+2053,Hartebeesthoek94 / Lo29,1262,projected,6503,,4326,16263,0
+31467,DHDN / 3-degree Gauss-Kruger zone 3,2326,projected,4530,,4314,16263,0
+31492,DHDN / Germany zone 2,2326,projected,4530,,4314,16262,1
+2263,NAD83 / New York Long Island (ftUS),1262,projected,4497,,4326,15339,0
+4807,NTF (Paris),1262,geographic 2D,6422,6807,,,0
+5714,MSL height,1262,vertical,6499,5100,,,0
+`)},
+	"epsg_alias.csv": {Data: []byte(`alias_code,object_table_name,object_code,naming_system_code,alias,remarks
+1,epsg_coordinatereferencesystem,31467,,Gauss-Krueger Zone 3,
+2,epsg_datum,6314,,Deutsches Hauptdreiecksnetz,
+`)},
+	"epsg_coordinateaxis.csv": {Data: []byte(`coord_axis_code,coord_sys_code,coord_axis_orientation,uom_code,coord_axis_order
+1,6422,north,9122,1
+2,6422,east,9122,2
+3,4530,north,9001,1
+4,4530,east,9001,2
+5,4497,east,9003,1
+6,4497,north,9003,2
+7,6423,north,9122,1
+8,6423,east,9122,2
+9,6423,up,9001,3
+10,6503,west,9001,1
+11,6503,south,9001,2
+`)},
+	"epsg_coordoperation.csv": {Data: []byte(`coord_op_code,coord_op_name,coord_op_type,source_crs_code,target_crs_code,coord_op_method_code,coord_op_accuracy,area_of_use_code,deprecated
+15869,DHDN to WGS 84 (1),transformation,4314,4326,9603,5,2326,0
+1777,DHDN to WGS 84 (3),transformation,4314,4326,9606,1,2326,0
+16263,3-degree Gauss-Kruger zone 3,conversion,,,9807,,,0
+15339,SPCS83 New York Long Island zone (US Survey feet),conversion,,,9802,,,0
+`)},
+	"epsg_coordoperationparamvalue.csv": {Data: []byte(`coord_op_code,coord_op_method_code,parameter_code,parameter_value,param_value_file_ref,uom_code
+15869,9603,8605,582,,9001
+15869,9603,8606,105,,9001
+15869,9603,8607,414,,9001
+1777,9606,8605,598.1,,9001
+1777,9606,8606,73.7,,9001
+1777,9606,8607,418.2,,9001
+1777,9606,8608,0.202,,9104
+1777,9606,8609,0.045,,9104
+1777,9606,8610,-2.455,,9104
+1777,9606,8611,6.7,,9202
+16263,9807,8801,0,,9102
+16263,9807,8802,9.0000,,9110
+16263,9807,8805,1,,9201
+16263,9807,8806,3500000,,9001
+16263,9807,8807,0,,9001
+`)},
+}
+
+func TestImport(t *testing.T) {
+	t.Parallel()
+
+	repo := &wgs84.Repository{}
+
+	unsupported, err := repo.Import(dataset)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(unsupported) != 4 || unsupported[0].Code != 2053 || unsupported[1].Code != 2263 ||
+		unsupported[2].Code != 4807 || unsupported[3].Code != 5714 ||
+		unsupported[0].Reason != "unsupported axis orientation west" {
+		t.Fatal("Failed", unsupported)
+	}
+
+	east, north, _ := repo.Transform(4326, 31467).Round(3)(9, 50, 0)
+	e, n, _ := wgs84.To(wgs84.DHDN2001GK(3)).Round(3)(9, 50, 0)
+
+	if east != e || north != n {
+		t.Fatal("Failed (2)", east, north, e, n)
+	}
+
+	if repo.Code(31492) != nil || repo.Code(31467).Contains(15, 50) {
+		t.Fatal("Failed (3)")
+	}
+
+	if code, ok := repo.ByAlias("gauss-krueger zone 3"); !ok || code != 31467 ||
+		repo.Name(31467) != "DHDN / 3-degree Gauss-Kruger zone 3" {
+		t.Fatal("Failed (4)", code, repo.Name(31467))
+	}
+
+	if ops := repo.Operations(6314, 6326); len(ops) != 2 || ops[0].Code != 1777 || ops[0].Accuracy != 1 ||
+		ops[1].Code != 15869 || !ops[1].Contains(9, 50) || ops[1].Contains(15, 50) {
+		t.Fatal("Failed (5)", ops)
+	}
+
+	if _, ok := repo.Code(4979).(wgs84.GeographicReferenceSystem); !ok {
+		t.Fatal("Failed (6)", repo.Code(4979))
+	}
+
+	if _, err := repo.Import(fstest.MapFS{}); err == nil {
+		t.Fatal("Failed (7)")
+	}
+}
+
+func TestImportMolodenskyBadekas(t *testing.T) {
+	t.Parallel()
+
+	// EPSG Guidance Note 7-2 example for La Canoa to REGVEN, with REGVEN taken as WGS84.
+	fsys := fstest.MapFS{
+		"epsg_unitofmeasure.csv":  dataset["epsg_unitofmeasure.csv"],
+		"epsg_coordinateaxis.csv": dataset["epsg_coordinateaxis.csv"],
+		"epsg_area.csv":           dataset["epsg_area.csv"],
+		"epsg_ellipsoid.csv": {Data: []byte(`ellipsoid_code,ellipsoid_name,semi_major_axis,uom_code,inv_flattening,semi_minor_axis
+7022,International 1924,6378388,9001,297,
+7030,WGS 84,6378137,9001,298.257223563,
+`)},
+		"epsg_datum.csv": {Data: []byte(`datum_code,datum_name,datum_type,ellipsoid_code,prime_meridian_code
+6247,La Canoa,geodetic,7022,8901
+6326,World Geodetic System 1984,geodetic,7030,8901
+`)},
+		"epsg_coordinatereferencesystem.csv": {Data: []byte(`coord_ref_sys_code,coord_ref_sys_name,area_of_use_code,coord_ref_sys_kind,coord_sys_code,datum_code,base_crs_code,projection_conv_code,deprecated
+4247,La Canoa,1262,geographic 2D,6422,6247,,,0
+4326,WGS 84,1262,geographic 2D,6422,6326,,,0
+`)},
+		"epsg_coordoperation.csv": {Data: []byte(`coord_op_code,coord_op_name,coord_op_type,source_crs_code,target_crs_code,coord_op_method_code,coord_op_accuracy,area_of_use_code,deprecated
+1,La Canoa to WGS 84,transformation,4247,4326,9636,1,1262,0
+`)},
+		"epsg_coordoperationparamvalue.csv": {Data: []byte(`coord_op_code,coord_op_method_code,parameter_code,parameter_value,param_value_file_ref,uom_code
+1,9636,8605,-270.933,,9001
+1,9636,8606,115.599,,9001
+1,9636,8607,-360.226,,9001
+1,9636,8608,-5.266,,9104
+1,9636,8609,-1.238,,9104
+1,9636,8610,2.381,,9104
+1,9636,8611,-5.109,,9202
+1,9636,8617,2464351.59,,9001
+1,9636,8618,-5783466.61,,9001
+1,9636,8667,974809.81,,9001
+`)},
+	}
+
+	repo := &wgs84.Repository{}
+
+	unsupported, err := repo.Import(fsys)
+	if err != nil || len(unsupported) != 0 {
+		t.Fatal("Failed", err, unsupported)
+	}
+
+	lon, lat, h := wgs84.XYZ().To(wgs84.LonLat())(2550138.46, -5749799.87, 1054530.82)
+	lon, lat, h = wgs84.Transform(repo.Code(4326), repo.Code(4247))(lon, lat, h)
+
+	x, y, z := wgs84.LaCanoa().LonLat().To(wgs84.LaCanoa().XYZ()).Round(1)(lon, lat, h)
+	if x != 2550409 || y != -5749912.3 || z != 1054891.1 {
+		t.Fatal("Failed (2)", x, y, z)
+	}
+}
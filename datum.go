@@ -23,6 +23,80 @@ func Helmert(a, fi, tx, ty, tz, rx, ry, rz, ds float64) Datum {
 	}
 }
 
+// RigorousHelmert provides a Datum specified through the major axis and the
+// inverse flattening of a spheroid and the 7 parameters of a Helmert-
+// Transformation in the given rotation convention.
+//
+// In contrast to Helmert it uses the full rotation matrix and its exact
+// inverse instead of the small angle approximation.
+func RigorousHelmert(a, fi, tx, ty, tz, rx, ry, rz, ds float64, convention HelmertConvention) Datum {
+	return Datum{
+		Spheroid:       spheroid{a: a, fi: fi},
+		Transformation: newRigorousHelmert(tx, ty, tz, rx, ry, rz, ds, convention),
+	}
+}
+
+// MolodenskyBadekas provides a Datum specified through the major axis and
+// the inverse flattening of a spheroid, the 7 parameters of a Helmert-
+// Transformation and the geocentric evaluation point px, py, pz of a
+// Molodensky-Badekas-Transformation.
+//
+// The rotations follow the position vector convention like Helmert.
+// Other datums that EPSG defines this way, such as those of Qatar and Fiji,
+// are built by Repository.Import from the EPSG dataset.
+func MolodenskyBadekas(a, fi, tx, ty, tz, rx, ry, rz, ds, px, py, pz float64) Datum {
+	return Datum{
+		Spheroid: spheroid{a: a, fi: fi},
+		Transformation: molodenskyBadekas{
+			helmert: helmert{
+				tx: tx,
+				ty: ty,
+				tz: tz,
+				rx: rx,
+				ry: ry,
+				rz: rz,
+				ds: ds,
+			},
+			px: px,
+			py: py,
+			pz: pz,
+		},
+	}
+}
+
+// Molodensky provides a Datum specified through the major axis and the
+// inverse flattening of a spheroid and the 3 parameters of a Molodensky-
+// Transformation to WGS84.
+func Molodensky(a, fi, tx, ty, tz float64) Datum {
+	return Datum{
+		Spheroid: spheroid{a: a, fi: fi},
+		Transformation: molodensky{
+			source: spheroid{a: a, fi: fi},
+			target: spheroid{a: A, fi: Fi},
+			tx:     tx,
+			ty:     ty,
+			tz:     tz,
+		},
+	}
+}
+
+// AbridgedMolodensky provides a Datum specified through the major axis and
+// the inverse flattening of a spheroid and the 3 parameters of an Abridged-
+// Molodensky-Transformation to WGS84.
+func AbridgedMolodensky(a, fi, tx, ty, tz float64) Datum {
+	return Datum{
+		Spheroid: spheroid{a: a, fi: fi},
+		Transformation: molodensky{
+			source:   spheroid{a: a, fi: fi},
+			target:   spheroid{a: A, fi: Fi},
+			tx:       tx,
+			ty:       ty,
+			tz:       tz,
+			abridged: true,
+		},
+	}
+}
+
 // WGS84 provides a Datum similar to the World Geodetic System 1984.
 //
 // It's based on the WGS84 Spheroid.
@@ -30,13 +104,12 @@ func Helmert(a, fi, tx, ty, tz, rx, ry, rz, ds float64) Datum {
 // It is used worldwide.
 func WGS84() Datum {
 	return Datum{
+		Code: 6326,
 		Spheroid: spheroid{
 			a:  A,
 			fi: Fi,
 		},
-		Area: AreaFunc(func(lon, lat float64) bool {
-			return math.Abs(lon) <= 180 && math.Abs(lat) <= 90
-		}),
+		Area: BoundingBox{West: -180, South: -90, East: 180, North: 90},
 	}
 }
 
@@ -48,10 +121,9 @@ func WGS84() Datum {
 // It is used in Europe.
 func ETRS89() Datum {
 	return Datum{
+		Code:     6258,
 		Spheroid: GRS80{},
-		Area: AreaFunc(func(lon, lat float64) bool {
-			return lon >= -16.1 && lon <= 40.18 && lat >= 32.88 && lat <= 84.17
-		}),
+		Area:     BoundingBox{West: -16.1, South: 32.88, East: 40.18, North: 84.17},
 	}
 }
 
@@ -65,6 +137,7 @@ func ETRS89() Datum {
 // It is used in Great Britain.
 func OSGB36() Datum {
 	return Datum{
+		Code:     6277,
 		Spheroid: Airy{},
 		Transformation: helmert{
 			tx: 446.448,
@@ -75,9 +148,7 @@ func OSGB36() Datum {
 			rz: 0.842,
 			ds: -20.489,
 		},
-		Area: AreaFunc(func(lon, lat float64) bool {
-			return lon >= -8.82 && lon <= 1.92 && lat >= 49.79 && lat <= 60.94
-		}),
+		Area: greatBritain,
 	}
 }
 
@@ -91,6 +162,7 @@ func OSGB36() Datum {
 // It is used in Austria.
 func MGI() Datum {
 	return Datum{
+		Code:     6312,
 		Spheroid: Bessel{},
 		Transformation: helmert{
 			tx: 577.326,
@@ -101,9 +173,7 @@ func MGI() Datum {
 			rz: 5.297,
 			ds: 2.4232,
 		},
-		Area: AreaFunc(func(lon, lat float64) bool {
-			return lon >= 9.53 && lon <= 17.17 && lat >= 46.4 && lat <= 49.02
-		}),
+		Area: austria,
 	}
 }
 
@@ -117,6 +187,7 @@ func MGI() Datum {
 // It is used in Germay.
 func DHDN2001() Datum {
 	return Datum{
+		Code:     6314,
 		Spheroid: Bessel{},
 		Transformation: helmert{
 			tx: 598.1,
@@ -127,9 +198,7 @@ func DHDN2001() Datum {
 			rz: -2.455,
 			ds: 6.7,
 		},
-		Area: AreaFunc(func(lon, lat float64) bool {
-			return lon >= 5.87 && lon <= 13.84 && lat >= 47.27 && lat <= 55.09
-		}),
+		Area: germany,
 	}
 }
 
@@ -140,10 +209,9 @@ func DHDN2001() Datum {
 // It is used in France.
 func RGF93() Datum {
 	return Datum{
+		Code:     6171,
 		Spheroid: GRS80{},
-		Area: AreaFunc(func(lon, lat float64) bool {
-			return lon >= -9.86 && lon <= 10.38 && lat >= 41.15 && lat <= 51.56
-		}),
+		Area:     france,
 	}
 }
 
@@ -154,22 +222,565 @@ func RGF93() Datum {
 // It is used in North-America.
 func NAD83() Datum {
 	return Datum{
+		Code:     6269,
+		Spheroid: GRS80{},
+		Area:     BoundingBox{West: -172.54, South: 23.81, East: -47.74, North: 86.46},
+	}
+}
+
+// NZGD49 provides a Datum similar to the New Zealand Geodetic Datum 1949.
+//
+// It's based on the International 1924 Spheroid and a 7-parameter-Helmert-
+// Transformation with the parameters: 59.47,-5.04,187.44,-0.47,0.1,-1.024,-4.5993.
+//
+// https://epsg.io/1564 (rotations converted from the coordinate frame convention)
+//
+// It is used in New Zealand.
+func NZGD49() Datum {
+	return Datum{
+		Code:     6272,
+		Spheroid: International1924{},
+		Transformation: helmert{
+			tx: 59.47,
+			ty: -5.04,
+			tz: 187.44,
+			rx: -0.47,
+			ry: 0.1,
+			rz: -1.024,
+			ds: -4.5993,
+		},
+		Area: newZealand,
+	}
+}
+
+// NZGD2000 provides a Datum similar to the New Zealand Geodetic Datum 2000.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in New Zealand.
+func NZGD2000() Datum {
+	return Datum{
+		Code:     6167,
 		Spheroid: GRS80{},
-		Area: AreaFunc(func(lon, lat float64) bool {
-			return lon >= -172.54 && lon <= -47.74 && lat >= 23.81 && lat <= 86.46
-		}),
+		Area:     newZealandChatham,
 	}
 }
 
+// NAD27 provides a Datum similar to the North American Datum 1927.
+//
+// It's based on the Clarke1866 Spheroid and a 3-parameter-Helmert-Transformation
+// with the parameters: -8,160,176.
+//
+// https://epsg.io/4267
+//
+// It is used in the contiguous United States.
+func NAD27() Datum {
+	return Datum{
+		Code:     6267,
+		Spheroid: Clarke1866{},
+		Transformation: helmert{
+			tx: -8,
+			ty: 160,
+			tz: 176,
+		},
+		Area: BoundingBox{West: -124.79, South: 24.41, East: -66.91, North: 49.38},
+	}
+}
+
+// ED50 provides a Datum similar to the European Datum 1950.
+//
+// It's based on the International 1924 Spheroid and a 3-parameter-Helmert-Transformation
+// with the parameters: -87,-98,-121.
+//
+// https://epsg.io/4230
+//
+// It is used in Europe.
+func ED50() Datum {
+	return Datum{
+		Code:     6230,
+		Spheroid: International1924{},
+		Transformation: helmert{
+			tx: -87,
+			ty: -98,
+			tz: -121,
+		},
+		Area: BoundingBox{West: -16.1, South: 25.71, East: 48.61, North: 84.73},
+	}
+}
+
+// GDA94 provides a Datum similar to the Geocentric Datum of Australia 1994.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/4283
+//
+// It is used in Australia.
+func GDA94() Datum {
+	return Datum{
+		Code:     6283,
+		Spheroid: GRS80{},
+		Area:     BoundingBox{West: 93.41, South: -60.56, East: 173.35, North: -8.47},
+	}
+}
+
+// GDA2020 provides a Datum similar to the Geocentric Datum of Australia 2020.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/7844
+//
+// It is used in Australia.
+func GDA2020() Datum {
+	return Datum{
+		Code:     1168,
+		Spheroid: GRS80{},
+		Area:     BoundingBox{West: 93.41, South: -60.56, East: 173.35, North: -8.47},
+	}
+}
+
+// Tokyo provides a Datum similar to Tokyo Datum.
+//
+// It's based on the Bessel Spheroid and a 3-parameter-Helmert-Transformation
+// with the parameters: -146.414,507.337,680.507.
+//
+// https://epsg.io/4301
+//
+// It is used in Japan.
+func Tokyo() Datum {
+	return Datum{
+		Code:     6301,
+		Spheroid: Bessel{},
+		Transformation: helmert{
+			tx: -146.414,
+			ty: 507.337,
+			tz: 680.507,
+		},
+		Area: BoundingBox{West: 122.83, South: 20.37, East: 154.05, North: 45.54},
+	}
+}
+
+// JGD2000 provides a Datum similar to the Japanese Geodetic Datum 2000.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/4612
+//
+// It is used in Japan.
+func JGD2000() Datum {
+	return Datum{
+		Code:     6612,
+		Spheroid: GRS80{},
+		Area:     BoundingBox{West: 122.38, South: 17.09, East: 157.65, North: 46.05},
+	}
+}
+
+// JGD2011 provides a Datum similar to the Japanese Geodetic Datum 2011.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/6668
+//
+// It is used in Japan.
+func JGD2011() Datum {
+	return Datum{
+		Code:     1128,
+		Spheroid: GRS80{},
+		Area:     BoundingBox{West: 122.38, South: 17.09, East: 157.65, North: 46.05},
+	}
+}
+
+// Pulkovo1942 provides a Datum similar to the Pulkovo 1942.
+//
+// It's based on the Krassowsky 1940 Spheroid and a 7-parameter-Helmert-Transformation
+// with the parameters: 23.92,-141.27,-80.9,0,0.35,0.82,-0.12.
+//
+// https://epsg.io/4284
+//
+// It is used in Russia.
+func Pulkovo1942() Datum {
+	return Datum{
+		Code:     6284,
+		Spheroid: Krassowsky1940{},
+		Transformation: helmert{
+			tx: 23.92,
+			ty: -141.27,
+			tz: -80.9,
+			ry: 0.35,
+			rz: 0.82,
+			ds: -0.12,
+		},
+		Area: BoundingBox{West: 19.57, South: 39.87, East: -168.97, North: 85.2},
+	}
+}
+
+// SIRGAS2000 provides a Datum similar to the Sistema de Referencia Geocéntrico para las Américas 2000.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/4674
+//
+// It is used in Latin America.
+func SIRGAS2000() Datum {
+	return Datum{
+		Code:     6674,
+		Spheroid: GRS80{},
+		Area:     BoundingBox{West: -122.19, South: -59.87, East: -25.28, North: 32.72},
+	}
+}
+
+// SAD69 provides a Datum similar to the South American Datum 1969.
+//
+// It's based on the GRS 1967 Modified Spheroid and a 3-parameter-Helmert-Transformation
+// with the parameters: -57,1,-41.
+//
+// https://epsg.io/4618
+//
+// It is used in South America.
+func SAD69() Datum {
+	return Datum{
+		Code:     6618,
+		Spheroid: GRS67Modified{},
+		Transformation: helmert{
+			tx: -57,
+			ty: 1,
+			tz: -41,
+		},
+		Area: BoundingBox{West: -91.72, South: -55.96, East: -32.64, North: 12.52},
+	}
+}
+
+// CGCS2000 provides a Datum similar to the China Geodetic Coordinate System 2000.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/4490
+//
+// It is used in China.
+func CGCS2000() Datum {
+	return Datum{
+		Code:     1043,
+		Spheroid: GRS80{},
+		Area:     BoundingBox{West: 73.62, South: 16.7, East: 134.77, North: 53.56},
+	}
+}
+
+// KGD2002 provides a Datum similar to the Korean Geodetic Datum 2002.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/4737
+//
+// It is used in South Korea.
+func KGD2002() Datum {
+	return Datum{
+		Code:     6737,
+		Spheroid: GRS80{},
+		Area:     BoundingBox{West: 122.71, South: 28.6, East: 134.28, North: 40.27},
+	}
+}
+
+// Hartebeesthoek94 provides a Datum similar to Hartebeesthoek94.
+//
+// It's based on the WGS84 Spheroid.
+//
+// https://epsg.io/4148
+//
+// It is used in South Africa.
+func Hartebeesthoek94() Datum {
+	return Datum{
+		Code:     6148,
+		Spheroid: spheroid{a: A, fi: Fi},
+		Area:     BoundingBox{West: 16.45, South: -34.88, East: 32.95, North: -22.13},
+	}
+}
+
+// Indian1975 provides a Datum similar to the Indian 1975.
+//
+// It's based on the Everest 1830 Spheroid and a 3-parameter-Helmert-Transformation
+// with the parameters: 209,818,290.
+//
+// https://epsg.io/4240
+//
+// It is used in Thailand.
+func Indian1975() Datum {
+	return Datum{
+		Code:     6240,
+		Spheroid: Everest1830{},
+		Transformation: helmert{
+			tx: 209,
+			ty: 818,
+			tz: 290,
+		},
+		Area: BoundingBox{West: 97.34, South: 5.63, East: 105.64, North: 20.46},
+	}
+}
+
+// Arc1960 provides a Datum similar to the Arc 1960.
+//
+// It's based on the Clarke 1880 Spheroid and a 3-parameter-Helmert-Transformation
+// with the parameters: -160,-6,-302.
+//
+// https://epsg.io/4210
+//
+// It is used in Kenya, Tanzania and Uganda.
+func Arc1960() Datum {
+	return Datum{
+		Code:     6210,
+		Spheroid: Clarke1880{},
+		Transformation: helmert{
+			tx: -160,
+			ty: -6,
+			tz: -302,
+		},
+		Area: BoundingBox{West: 28.85, South: -11.75, East: 41.91, North: 4.63},
+	}
+}
+
+// Adindan provides a Datum similar to Adindan.
+//
+// It's based on the Clarke 1880 Spheroid and a 3-parameter-Helmert-Transformation
+// with the parameters: -166,-15,204.
+//
+// https://epsg.io/4201
+//
+// It is used in Ethiopia and Sudan.
+func Adindan() Datum {
+	return Datum{
+		Code:     6201,
+		Spheroid: Clarke1880{},
+		Transformation: helmert{
+			tx: -166,
+			ty: -15,
+			tz: 204,
+		},
+		Area: BoundingBox{West: 21.82, South: 3.4, East: 47.99, North: 22.24},
+	}
+}
+
+// WGS72 provides a Datum similar to the World Geodetic System 1972.
+//
+// It's based on the WGS72 Spheroid and a 7-parameter-Helmert-Transformation
+// with the parameters: 0,0,4.5,0,0,0.554,0.2263.
+//
+// https://epsg.io/4322
+//
+// It is used in worldwide.
+func WGS72() Datum {
+	return Datum{
+		Code:     6322,
+		Spheroid: WGS72Spheroid{},
+		Transformation: helmert{
+			tz: 4.5,
+			rz: 0.554,
+			ds: 0.2263,
+		},
+		Area: BoundingBox{West: -180, South: -90, East: 180, North: 90},
+	}
+}
+
+// Amersfoort provides a Datum similar to Amersfoort.
+//
+// It's based on the Bessel Spheroid and a 7-parameter-Helmert-Transformation
+// with the parameters: 565.4171,50.3319,465.5524,-0.398957,0.343988,-1.8774,4.0725.
+//
+// https://epsg.io/4289
+//
+// It is used in the Netherlands.
+func Amersfoort() Datum {
+	return Datum{
+		Code:     6289,
+		Spheroid: Bessel{},
+		Transformation: helmert{
+			tx: 565.4171,
+			ty: 50.3319,
+			tz: 465.5524,
+			rx: -0.398957,
+			ry: 0.343988,
+			rz: -1.8774,
+			ds: 4.0725,
+		},
+		Area: netherlands,
+	}
+}
+
+// Belge1972 provides a Datum similar to the Reseau National Belge 1972.
+//
+// It's based on the International 1924 Spheroid and a 7-parameter-Helmert-Transformation
+// with the parameters: -106.8686,52.2978,-103.7239,0.3366,-0.457,1.8422,-1.2747.
+//
+// https://epsg.io/4313
+//
+// It is used in Belgium.
+func Belge1972() Datum {
+	return Datum{
+		Code:     6313,
+		Spheroid: International1924{},
+		Transformation: helmert{
+			tx: -106.8686,
+			ty: 52.2978,
+			tz: -103.7239,
+			rx: 0.3366,
+			ry: -0.457,
+			rz: 1.8422,
+			ds: -1.2747,
+		},
+		Area: belgium,
+	}
+}
+
+// CH1903 provides a Datum similar to CH1903.
+//
+// It's based on the Bessel Spheroid and a 3-parameter-Helmert-Transformation
+// with the parameters: 674.374,15.056,405.346.
+//
+// https://epsg.io/4149
+//
+// It is used in Switzerland.
+func CH1903() Datum {
+	return Datum{
+		Code:     6149,
+		Spheroid: Bessel{},
+		Transformation: helmert{
+			tx: 674.374,
+			ty: 15.056,
+			tz: 405.346,
+		},
+		Area: switzerland,
+	}
+}
+
+// Egypt1907 provides a Datum similar to the Egypt 1907.
+//
+// It's based on the Helmert 1906 Spheroid and a 3-parameter-Helmert-Transformation
+// with the parameters: -130,110,-13.
+//
+// https://epsg.io/4229
+//
+// It is used in Egypt.
+func Egypt1907() Datum {
+	return Datum{
+		Code:     6229,
+		Spheroid: Helmert1906{},
+		Transformation: helmert{
+			tx: -130,
+			ty: 110,
+			tz: -13,
+		},
+		Area: BoundingBox{West: 24.7, South: 21.89, East: 36.95, North: 31.68},
+	}
+}
+
+// Kertau1968 provides a Datum similar to the Kertau 1968.
+//
+// It's based on the Everest 1830 Modified Spheroid and a 3-parameter-Helmert-Transformation
+// with the parameters: -11,851,5.
+//
+// https://epsg.io/4245
+//
+// It is used in West Malaysia and Singapore.
+func Kertau1968() Datum {
+	return Datum{
+		Code:     6245,
+		Spheroid: Everest1830Modified{},
+		Transformation: helmert{
+			tx: -11,
+			ty: 851,
+			tz: 5,
+		},
+		Area: BoundingBox{West: 99.59, South: 1.13, East: 104.6, North: 7.81},
+	}
+}
+
+// Timbalai1948 provides a Datum similar to the Timbalai 1948.
+//
+// It's based on the Everest 1967 Spheroid and a 3-parameter-Helmert-Transformation
+// with the parameters: -679,669,-48.
+//
+// https://epsg.io/4298
+//
+// It is used in Brunei and East Malaysia.
+func Timbalai1948() Datum {
+	return Datum{
+		Code:     6298,
+		Spheroid: Everest1967{},
+		Transformation: helmert{
+			tx: -679,
+			ty: 669,
+			tz: -48,
+		},
+		Area: BoundingBox{West: 109.31, South: 0.85, East: 119.61, North: 7.67},
+	}
+}
+
+// LaCanoa provides a Datum similar to La Canoa.
+//
+// It's based on the International 1924 Spheroid and a Molodensky-Badekas-
+// Transformation with the parameters: -270.933,115.599,-360.226,5.266,1.238,
+// -2.381,-5.109 and the evaluation point 2464351.59,-5783466.61,974809.81.
+//
+// https://epsg.io/1771 (rotations converted from the coordinate frame convention)
+//
+// It is used in Venezuela.
+func LaCanoa() Datum {
+	return Datum{
+		Code:     6247,
+		Spheroid: International1924{},
+		Transformation: molodenskyBadekas{
+			helmert: helmert{
+				tx: -270.933,
+				ty: 115.599,
+				tz: -360.226,
+				rx: 5.266,
+				ry: 1.238,
+				rz: -2.381,
+				ds: -5.109,
+			},
+			px: 2464351.59,
+			py: -5783466.61,
+			pz: 974809.81,
+		},
+		Area: BoundingBox{West: -73.38, South: 0.64, East: -59.8, North: 12.25},
+	}
+}
+
+// BodyDatum provides a body-centered Datum of a celestial Body based on its
+// IAU 2015 Spheroid.
+//
+// Coordinates of other bodies are not related to WGS84. The geocentric
+// coordinates are just passed through and SafeTransform rejects
+// transformations between different bodies.
+func BodyDatum(b Body) Datum {
+	return Datum{
+		Spheroid: b,
+		Area:     BoundingBox{West: -180, South: -90, East: 180, North: 90},
+		Body:     b,
+	}
+}
+
+// Moon2015 provides the Datum of the Moon similar to IAU_2015:30100.
+func Moon2015() Datum {
+	return BodyDatum(Moon)
+}
+
+// Mars2015 provides the Datum of Mars similar to IAU_2015:49901.
+func Mars2015() Datum {
+	return BodyDatum(Mars)
+}
+
 // Datum represents a Geodetic Datum like WGS84, ETRS89 or NAD83.
 //
 // It implements the Spheroid, Transformation and Area interface.
 //
-// By default it behaves like a WGS84 Datum.
+// By default it behaves like a WGS84 Datum on the Earth.
+//
+// The Code is the EPSG-Code of the datum like 6326 for WGS84. It identifies
+// the Datum in the Operations of a Repository and is 0 for custom datums.
 type Datum struct {
+	Code           int
 	Spheroid       Spheroid
 	Transformation Transformation
 	Area           Area
+	Body           Body
 }
 
 // Contains method is the implementation of the Area interface.
@@ -239,6 +850,44 @@ func (d Datum) LonLat() GeographicReferenceSystem {
 	}
 }
 
+// RotatedLonLat is a rotated-pole geographic Coordinate Reference System.
+func (d Datum) RotatedLonLat(poleLon, poleLat, northPoleGridLon float64) RotatedReferenceSystem {
+	return RotatedReferenceSystem{
+		Datum:            d,
+		PoleLon:          poleLon,
+		PoleLat:          poleLat,
+		NorthPoleGridLon: northPoleGridLon,
+	}
+}
+
+// Topocentric is a local tangent plane Coordinate Reference System around an
+// origin at lon, lat and the ellipsoidal height h.
+func (d Datum) Topocentric(lon, lat, h float64, axes TopocentricAxes) TopocentricReferenceSystem {
+	return TopocentricReferenceSystem{
+		Datum: d,
+		Lon:   lon,
+		Lat:   lat,
+		H:     h,
+		Axes:  axes,
+	}
+}
+
+// Planetocentric is a Coordinate Reference System of planetocentric
+// longitudes, latitudes and radii.
+func (d Datum) Planetocentric() PlanetocentricReferenceSystem {
+	return PlanetocentricReferenceSystem{
+		Datum: d,
+	}
+}
+
+// Planetographic is a Coordinate Reference System of planetographic
+// longitudes, latitudes and heights.
+func (d Datum) Planetographic() PlanetographicReferenceSystem {
+	return PlanetographicReferenceSystem{
+		Datum: d,
+	}
+}
+
 // WebMercator is a projected Coordinate Reference System.
 func (d Datum) WebMercator() ProjectedReferenceSystem {
 	return ProjectedReferenceSystem{
@@ -291,6 +940,23 @@ func (d Datum) AlbersEqualAreaConic(lonf, latf, lat1, lat2, eastf, northf float6
 	}
 }
 
+// NewZealandMapGrid is a projected Coordinate Reference System.
+//
+// The complex polynomial coefficients are only valid for the New Zealand Map
+// Grid origin at 173°E 41°S with the false origin 2510000, 6023150.
+func (d Datum) NewZealandMapGrid() ProjectedReferenceSystem {
+	return ProjectedReferenceSystem{
+		Datum: d,
+		Projection: newZealandMapGrid{
+			lonf:   173,
+			latf:   -41,
+			eastf:  2510000,
+			northf: 6023150,
+		},
+	}
+}
+
+// LambertAzimuthalEqualArea is a projected Coordinate Reference System.
 func (d Datum) LambertAzimuthalEqualArea(lonf, latf, eastf, northf float64) ProjectedReferenceSystem {
 	return ProjectedReferenceSystem{
 		Datum: d,
@@ -0,0 +1,146 @@
+//nolint:varnamelen
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func dms(d, m, s float64) float64 {
+	return math.Copysign(math.Abs(d)+m/60+s/3600, d)
+}
+
+func distance(lon, lat, lon2, lat2 float64) float64 {
+	x, y, z := wgs84.LonLat().To(wgs84.XYZ())(lon, lat, 0)
+	x2, y2, z2 := wgs84.LonLat().To(wgs84.XYZ())(lon2, lat2, 0)
+
+	return math.Sqrt((x-x2)*(x-x2) + (y-y2)*(y-y2) + (z-z2)*(z-z2))
+}
+
+func TestDatums(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		name     string
+		datum    wgs84.Datum
+		lon, lat float64
+		wlon     float64
+		wlat     float64
+		accuracy float64
+	}{
+		// NGS datasheet of Meades Ranch, NAD83 taken as WGS84.
+		{"NAD27", wgs84.NAD27(), dms(-98, 32, 30.506), dms(39, 13, 26.686),
+			dms(-98, 32, 31.7454), dms(39, 13, 26.7122), 10},
+		// EPSG Guidance Note 7-2 example for WGS 84 to ED50.
+		{"ED50", wgs84.ED50(), dms(2, 7, 51.477), dms(53, 48, 36.563),
+			dms(2, 7, 46.38), dms(53, 48, 33.82), 10},
+		// GSI origin of the Japanese geodetic datum, JGD2000 taken as WGS84.
+		{"Tokyo", wgs84.Tokyo(), dms(139, 44, 40.502), dms(35, 39, 17.5148),
+			dms(139, 44, 28.8759), dms(35, 39, 29.1572), 1},
+		// RDNAPTRANS origin of the Rijksdriehoeksmeting at Amersfoort.
+		{"Amersfoort", wgs84.Amersfoort(), dms(5, 23, 15.5), dms(52, 9, 22.178),
+			5.38720621, 52.15517440, 1},
+		// swisstopo origin of the Swiss projection at the old observatory of Bern.
+		{"CH1903", wgs84.CH1903(), dms(7, 26, 22.5), dms(46, 57, 8.66),
+			7.43863722, 46.95108111, 1},
+	} {
+		lon, lat, _ := c.datum.LonLat().To(wgs84.LonLat())(c.lon, c.lat, 0)
+		if d := distance(lon, lat, c.wlon, c.wlat); d > c.accuracy {
+			t.Fatal("Failed", c.name, d)
+		}
+	}
+
+	// EPSG Guidance Note 7-2 example for WGS 72 to WGS 84.
+	x, y, z := wgs84.WGS72().XYZ().To(wgs84.XYZ())(3657660.66, 255768.55, 5201382.11)
+	if d := math.Sqrt((x-3657660.78)*(x-3657660.78) + (y-255778.43)*(y-255778.43) +
+		(z-5201387.75)*(z-5201387.75)); d > 2 {
+		t.Fatal("Failed (2)", x, y, z)
+	}
+}
+
+func TestDatumParameters(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		name     string
+		datum    wgs84.Datum
+		spheroid wgs84.Spheroid
+		params   [7]float64
+		lon, lat float64
+	}{
+		{"Pulkovo1942", wgs84.Pulkovo1942(), wgs84.Krassowsky1940{},
+			[7]float64{23.92, -141.27, -80.9, 0, 0.35, 0.82, -0.12}, 37.62, 55.75},
+		{"SAD69", wgs84.SAD69(), wgs84.GRS67Modified{},
+			[7]float64{-57, 1, -41}, -47.88, -15.79},
+		{"Indian1975", wgs84.Indian1975(), wgs84.Everest1830{},
+			[7]float64{209, 818, 290}, 100.5, 13.75},
+		{"Arc1960", wgs84.Arc1960(), wgs84.Clarke1880{},
+			[7]float64{-160, -6, -302}, 36.82, -1.29},
+		{"Adindan", wgs84.Adindan(), wgs84.Clarke1880{},
+			[7]float64{-166, -15, 204}, 38.75, 9.03},
+		{"Belge1972", wgs84.Belge1972(), wgs84.International1924{},
+			[7]float64{-106.8686, 52.2978, -103.7239, 0.3366, -0.457, 1.8422, -1.2747}, 4.35, 50.85},
+		{"Egypt1907", wgs84.Egypt1907(), wgs84.Helmert1906{},
+			[7]float64{-130, 110, -13}, 31.24, 30.04},
+		{"Kertau1968", wgs84.Kertau1968(), wgs84.Everest1830Modified{},
+			[7]float64{-11, 851, 5}, 101.69, 3.14},
+		{"Timbalai1948", wgs84.Timbalai1948(), wgs84.Everest1967{},
+			[7]float64{-679, 669, -48}, 114.94, 4.89},
+		{"WGS72", wgs84.WGS72(), wgs84.WGS72Spheroid{},
+			[7]float64{0, 0, 4.5, 0, 0, 0.554, 0.2263}, 0, 0},
+		{"GDA94", wgs84.GDA94(), wgs84.GRS80{}, [7]float64{}, 149.13, -35.28},
+		{"GDA2020", wgs84.GDA2020(), wgs84.GRS80{}, [7]float64{}, 149.13, -35.28},
+		{"JGD2000", wgs84.JGD2000(), wgs84.GRS80{}, [7]float64{}, 139.69, 35.69},
+		{"JGD2011", wgs84.JGD2011(), wgs84.GRS80{}, [7]float64{}, 139.69, 35.69},
+		{"SIRGAS2000", wgs84.SIRGAS2000(), wgs84.GRS80{}, [7]float64{}, -47.88, -15.79},
+		{"CGCS2000", wgs84.CGCS2000(), wgs84.GRS80{}, [7]float64{}, 116.39, 39.91},
+		{"KGD2002", wgs84.KGD2002(), wgs84.GRS80{}, [7]float64{}, 126.98, 37.57},
+		{"Hartebeesthoek94", wgs84.Hartebeesthoek94(), wgs84.WGS84(), [7]float64{}, 28.19, -25.75},
+	} {
+		if c.datum.A() != c.spheroid.A() || c.datum.Fi() != c.spheroid.Fi() {
+			t.Fatal("Failed", c.name, c.datum.A(), c.datum.Fi())
+		}
+
+		if !c.datum.Contains(c.lon, c.lat) {
+			t.Fatal("Failed (2)", c.name, c.lon, c.lat)
+		}
+
+		p := c.params
+		helmert := wgs84.Helmert(c.spheroid.A(), c.spheroid.Fi(), p[0], p[1], p[2], p[3], p[4], p[5], p[6])
+
+		lon, lat, h := c.datum.LonLat().To(wgs84.LonLat())(c.lon, c.lat, 0)
+		lon2, lat2, h2 := helmert.LonLat().To(wgs84.LonLat())(c.lon, c.lat, 0)
+
+		if distance(lon, lat, lon2, lat2) > 0.001 || math.Abs(h-h2) > 0.001 {
+			t.Fatal("Failed (3)", c.name, lon-lon2, lat-lat2, h-h2)
+		}
+	}
+}
+
+func TestSpheroids(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		code     int
+		spheroid wgs84.Spheroid
+		a, fi    float64
+	}{
+		{7008, wgs84.Clarke1866{}, 6378206.4, 294.9786982},
+		{7022, wgs84.International1924{}, 6378388, 297},
+		{7024, wgs84.Krassowsky1940{}, 6378245, 298.3},
+		{7012, wgs84.Clarke1880{}, 6378249.145, 293.465},
+		{7011, wgs84.Clarke1880IGN{}, 6378249.2, 293.4660213},
+		{7015, wgs84.Everest1830{}, 6377276.345, 300.8017},
+		{7018, wgs84.Everest1830Modified{}, 6377304.063, 300.8017},
+		{7016, wgs84.Everest1967{}, 6377298.556, 300.8017},
+		{7043, wgs84.WGS72Spheroid{}, 6378135, 298.26},
+		{7020, wgs84.Helmert1906{}, 6378200, 298.3},
+		{7050, wgs84.GRS67Modified{}, 6378160, 298.25},
+	} {
+		if c.spheroid.A() != c.a || math.Abs(c.spheroid.Fi()-c.fi) > 1e-7 {
+			t.Fatal("Failed", c.code, c.spheroid.A(), c.spheroid.Fi())
+		}
+	}
+}
@@ -2,69 +2,170 @@
 package wgs84
 
 import (
+	"fmt"
 	"sync"
 )
 
 // EPSG returns a Repository for dealing with several EPSG-Codes and
 // CoordinateReferenceSystems.
+//
+// The vertical systems EGM96 height (5773), EGM2008 height (3855), DHHN2016
+// height (7837) and NAVD88 height (5703) use the geoid grids egm96_15.gtx,
+// egm08_25.gtx, GCG2016.gtx and g2012a_conus.gtx from the PROJ data
+// directories, see GeoidFile. Lookup returns ErrGeoidGrid if a grid is
+// missing. Other vertical systems are added by AddVertical.
 func EPSG() *Repository {
-	codes := map[int]CoordinateReferenceSystem{
-		4326:   LonLat(),
-		4978:   XYZ(),
-		3857:   WebMercator(),
-		900913: WebMercator(),
-		4258:   ETRS89().LonLat(),
-		3416:   ETRS89AustriaLambert(),
-		3035:   ETRS89LambertAzimuthalEqualArea(),
-		31287:  MGIAustriaLambert(),
-		31284:  MGIAustriaM28(),
-		31285:  MGIAustriaM31(),
-		31286:  MGIAustriaM34(),
-		31257:  MGIAustriaGKM28(),
-		31258:  MGIAustriaGKM31(),
-		31259:  MGIAustriaGKM34(),
-		4314:   DHDN2001().LonLat(),
-		27700:  OSGB36NationalGrid(),
-		4277:   OSGB36().LonLat(),
-		4171:   RGF93().LonLat(),
-		2154:   RGF93FranceLambert(),
-		4269:   NAD83().LonLat(),
-		6355:   NAD83AlabamaEast(),
-		6356:   NAD83AlabamaWest(),
-		6414:   NAD83CaliforniaAlbers(),
-		3161:   NAD83OntarioMNRlambert(),
+	codes := map[int]epsgCode{
+		4326:   {LonLat(), "WGS 84", []string{"WGS84", "World Geodetic System 1984"}},
+		4978:   {XYZ(), "WGS 84 (geocentric)", nil},
+		3857:   {WebMercator(), "WGS 84 / Pseudo-Mercator", []string{"Web Mercator", "Pseudo-Mercator", "Google Mercator"}},
+		900913: {WebMercator(), "Google Maps Global Mercator", []string{"Google Mercator"}},
+		4258:   {ETRS89().LonLat(), "ETRS89", nil},
+		3416:   {ETRS89AustriaLambert(), "ETRS89 / Austria Lambert", nil},
+		3035:   {ETRS89LambertAzimuthalEqualArea(), "ETRS89-extended / LAEA Europe", []string{"ETRS89 / LAEA Europe", "LAEA Europe"}},
+		31287:  {MGIAustriaLambert(), "MGI / Austria Lambert", nil},
+		31284:  {MGIAustriaM28(), "MGI / Austria M28", nil},
+		31285:  {MGIAustriaM31(), "MGI / Austria M31", nil},
+		31286:  {MGIAustriaM34(), "MGI / Austria M34", nil},
+		31257:  {MGIAustriaGKM28(), "MGI / Austria GK M28", nil},
+		31258:  {MGIAustriaGKM31(), "MGI / Austria GK M31", nil},
+		31259:  {MGIAustriaGKM34(), "MGI / Austria GK M34", nil},
+		4314:   {DHDN2001().LonLat(), "DHDN", nil},
+		27700:  {OSGB36NationalGrid(), "OSGB36 / British National Grid", []string{"British National Grid", "OSGB 1936 / British National Grid"}},
+		4277:   {OSGB36().LonLat(), "OSGB36", []string{"OSGB 1936"}},
+		4171:   {RGF93().LonLat(), "RGF93 v1", nil},
+		2154:   {RGF93FranceLambert(), "RGF93 v1 / Lambert-93", []string{"Lambert 93", "RGF93 / Lambert-93"}},
+		4269:   {NAD83().LonLat(), "NAD83", nil},
+		6355:   {NAD83AlabamaEast(), "NAD83(2011) / Alabama East", nil},
+		6356:   {NAD83AlabamaWest(), "NAD83(2011) / Alabama West", nil},
+		6414:   {NAD83CaliforniaAlbers(), "NAD83(2011) / California Albers", nil},
+		3161:   {NAD83OntarioMNRlambert(), "NAD83 / Ontario MNR Lambert", nil},
+		4272:   {NZGD49().LonLat(), "NZGD49", nil},
+		27200:  {NZGD49NewZealandMapGrid(), "NZGD49 / New Zealand Map Grid", []string{"New Zealand Map Grid", "NZMG"}},
+		4167:   {NZGD2000().LonLat(), "NZGD2000", nil},
+		2193:   {NZGD2000TransverseMercator(), "NZGD2000 / New Zealand Transverse Mercator 2000", []string{"NZTM2000", "NZTM"}},
+		4267:   {NAD27().LonLat(), "NAD27", nil},
+		4230:   {ED50().LonLat(), "ED50", nil},
+		4283:   {GDA94().LonLat(), "GDA94", nil},
+		7844:   {GDA2020().LonLat(), "GDA2020", nil},
+		4301:   {Tokyo().LonLat(), "Tokyo", nil},
+		4612:   {JGD2000().LonLat(), "JGD2000", nil},
+		6668:   {JGD2011().LonLat(), "JGD2011", nil},
+		4284:   {Pulkovo1942().LonLat(), "Pulkovo 1942", nil},
+		4674:   {SIRGAS2000().LonLat(), "SIRGAS 2000", nil},
+		4618:   {SAD69().LonLat(), "SAD69", nil},
+		4490:   {CGCS2000().LonLat(), "China Geodetic Coordinate System 2000", []string{"CGCS2000"}},
+		4737:   {KGD2002().LonLat(), "Korea 2000", []string{"KGD2002"}},
+		4148:   {Hartebeesthoek94().LonLat(), "Hartebeesthoek94", nil},
+		4240:   {Indian1975().LonLat(), "Indian 1975", nil},
+		4210:   {Arc1960().LonLat(), "Arc 1960", nil},
+		4201:   {Adindan().LonLat(), "Adindan", nil},
+		4322:   {WGS72().LonLat(), "WGS 72", nil},
+		4289:   {Amersfoort().LonLat(), "Amersfoort", nil},
+		4313:   {Belge1972().LonLat(), "Belge 1972", nil},
+		4149:   {CH1903().LonLat(), "CH1903", nil},
+		4229:   {Egypt1907().LonLat(), "Egypt 1907", nil},
+		4245:   {Kertau1968().LonLat(), "Kertau 1968", nil},
+		4298:   {Timbalai1948().LonLat(), "Timbalai 1948", nil},
+		4247:   {LaCanoa().LonLat(), "La Canoa", nil},
 	}
 
 	for i := 1; i < 61; i++ {
-		codes[32600+i] = UTM(float64(i), true)
-		codes[32700+i] = UTM(float64(i), false)
+		codes[32600+i] = epsgCode{UTM(float64(i), true), fmt.Sprintf("WGS 84 / UTM zone %dN", i), nil}
+		codes[32700+i] = epsgCode{UTM(float64(i), false), fmt.Sprintf("WGS 84 / UTM zone %dS", i), nil}
 	}
 
 	for i := 1; i < 24; i++ {
-		codes[26900+i] = UTMNAD83(float64(i))
+		codes[26900+i] = epsgCode{UTMNAD83(float64(i)), fmt.Sprintf("NAD83 / UTM zone %dN", i), nil}
 	}
 
 	for i := 42; i < 51; i++ {
-		codes[3900+i] = RGF93CC(float64(i))
+		codes[3900+i] = epsgCode{RGF93CC(float64(i)), fmt.Sprintf("RGF93 v1 / CC%d", i), nil}
 	}
 
 	for i := 2; i < 6; i++ {
-		codes[31464+i] = DHDN2001GK(float64(i))
+		codes[31464+i] = epsgCode{DHDN2001GK(float64(i)), fmt.Sprintf("DHDN / 3-degree Gauss-Kruger zone %d", i), nil}
 	}
 
 	for i := 28; i < 39; i++ {
-		codes[25800+i] = ETRS89UTM(float64(i))
+		codes[25800+i] = epsgCode{ETRS89UTM(float64(i)), fmt.Sprintf("ETRS89 / UTM zone %dN", i), nil}
 	}
 
-	return &Repository{
-		codes: codes,
+	for i, circuit := range []string{
+		"Mount Eden", "Bay of Plenty", "Poverty Bay", "Hawkes Bay", "Taranaki",
+		"Tuhirangi", "Wanganui", "Wairarapa", "Wellington", "Collingwood",
+		"Nelson", "Karamea", "Buller", "Grey", "Amuri", "Marlborough",
+		"Hokitika", "Okarito", "Jacksons Bay", "Mount Pleasant", "Gawler",
+		"Timaru", "Lindis Peak", "Mount Nicholas", "Mount York",
+		"Observation Point", "North Taieri", "Bluff",
+	} {
+		codes[2105+i] = epsgCode{NZGD2000Circuit(i + 1), fmt.Sprintf("NZGD2000 / %s 2000", circuit), nil}
 	}
+
+	identifiers := map[Identifier]CoordinateReferenceSystem{
+		{"OGC", "CRS84"}:    LonLat(),
+		{"ESRI", "102100"}:  WebMercator(),
+		{"ESRI", "102113"}:  WebMercator(),
+		{"IGNF", "WGS84G"}:  LonLat(),
+		{"IGNF", "RGF93G"}:  RGF93().LonLat(),
+		{"IGNF", "LAMB93"}:  RGF93FranceLambert(),
+		{"IGNF", "ETRS89G"}: ETRS89().LonLat(),
+	}
+
+	verticals := map[int]epsgVertical{
+		5773: {VerticalDatum{Geoid: GeoidFile("egm96_15.gtx")}, "EGM96 height"},
+		3855: {VerticalDatum{Geoid: GeoidFile("egm08_25.gtx")}, "EGM2008 height"},
+		7837: {VerticalDatum{Geoid: GeoidFile("GCG2016.gtx"), Area: germany}, "DHHN2016 height"},
+		5703: {VerticalDatum{Geoid: GeoidFile("g2012a_conus.gtx"), Area: NAD83().Area}, "NAVD88 height"},
+	}
+
+	r := &Repository{
+		codes:       map[int]CoordinateReferenceSystem{},
+		identifiers: identifiers,
+		verticals:   map[int]VerticalReferenceSystem{},
+		names:       map[int]string{},
+		aliases:     map[int][]string{},
+		operations:  epsgOperations(),
+	}
+
+	for c, e := range codes {
+		r.codes[c], r.names[c] = e.crs, e.name
+
+		if len(e.aliases) > 0 {
+			r.aliases[c] = e.aliases
+		}
+	}
+
+	for c, e := range verticals {
+		r.verticals[c], r.names[c] = e.vertical, e.name
+	}
+
+	return r
+}
+
+// epsgCode is a CoordinateReferenceSystem of EPSG with its name and aliases.
+type epsgCode struct {
+	crs     CoordinateReferenceSystem
+	name    string
+	aliases []string
+}
+
+// epsgVertical is a VerticalReferenceSystem of EPSG with its name.
+type epsgVertical struct {
+	vertical VerticalReferenceSystem
+	name     string
 }
 
 // Repository holds the EPSG-Codes and CoordinateReferenceSystems.
 type Repository struct {
-	codes map[int]CoordinateReferenceSystem
-	mutex sync.Mutex
+	codes       map[int]CoordinateReferenceSystem
+	identifiers map[Identifier]CoordinateReferenceSystem
+	verticals   map[int]VerticalReferenceSystem
+	names       map[int]string
+	aliases     map[int][]string
+	operations  []Operation
+	index       *coverIndex
+	mutex       sync.Mutex
 }
 
 // Code returns a CoordinateReferenceSystem of a specific EPSG-Code.
@@ -97,9 +198,95 @@ func (r *Repository) Add(c int, crs CoordinateReferenceSystem) {
 
 	r.mutex.Lock()
 	r.codes[c] = crs
+	r.index = nil
+	r.mutex.Unlock()
+}
+
+// AddVertical adds the EPSG-Code of a VerticalReferenceSystem to the
+// Repository.
+func (r *Repository) AddVertical(c int, v VerticalReferenceSystem) {
+	if v == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	if r.verticals == nil {
+		r.verticals = map[int]VerticalReferenceSystem{}
+	}
+
+	r.verticals[c] = v
 	r.mutex.Unlock()
 }
 
+// Vertical returns a VerticalReferenceSystem of a specific EPSG-Code.
+func (r *Repository) Vertical(c int) VerticalReferenceSystem {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.verticals[c]
+}
+
+// Compound returns a CompoundReferenceSystem of a horizontal and a vertical
+// EPSG-Code.
+//
+// Returns nil if one of the codes is unknown or the geoid grid of the
+// vertical code can't be loaded.
+func (r *Repository) Compound(horizontal, vertical int) CoordinateReferenceSystem {
+	h, v := r.Code(horizontal), r.Vertical(vertical)
+	if h == nil || v == nil || verticalError(v) != nil {
+		return nil
+	}
+
+	return CompoundReferenceSystem{
+		Horizontal: h,
+		Vertical:   v,
+	}
+}
+
+// Lookup returns a CoordinateReferenceSystem of an identifier like "25832",
+// "EPSG:25832", "ESRI:102100" or "urn:ogc:def:crs:EPSG::25832" or of a
+// compound identifier like "25832+7837". See ParseIdentifier for all forms.
+func (r *Repository) Lookup(code string) (CoordinateReferenceSystem, error) {
+	horizontal, vertical, compound := cutCompound(code)
+
+	h, err := ParseIdentifier(horizontal)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCode, code)
+	}
+
+	if !compound {
+		crs := r.Identifier(h)
+		if crs == nil {
+			return nil, fmt.Errorf("%w: %s", ErrNoCoordinateReferenceSystem, code)
+		}
+
+		return crs, nil
+	}
+
+	id, err := ParseIdentifier(vertical)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCode, code)
+	}
+
+	hcrs, v := r.Identifier(h), VerticalReferenceSystem(nil)
+	if c, ok := id.EPSG(); ok {
+		v = r.Vertical(c)
+	}
+
+	if hcrs == nil || v == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoCoordinateReferenceSystem, code)
+	}
+
+	if err := verticalError(v); err != nil {
+		return nil, fmt.Errorf("%s: %w", code, err)
+	}
+
+	return CompoundReferenceSystem{
+		Horizontal: hcrs,
+		Vertical:   v,
+	}, nil
+}
+
 // Codes returns all available codes.
 func (r *Repository) Codes() []int {
 	r.mutex.Lock()
@@ -117,21 +304,13 @@ func (r *Repository) Codes() []int {
 	return cc
 }
 
-// CodesCover returns all Codes covering a specific geographic WGS84 location.
+// CodesCover returns all Codes covering a specific geographic WGS84 location
+// in sorted order.
+//
+// The Codes are looked up in a spatial index over the bounds of the areas of
+// use and refined by the Contains methods.
 func (r *Repository) CodesCover(lon, lat float64) []int {
-	r.mutex.Lock()
-
-	var cc []int
-
-	for c, crs := range r.codes {
-		if crs.Contains(lon, lat) {
-			cc = append(cc, c)
-		}
-	}
-
-	r.mutex.Unlock()
-
-	return cc
+	return r.coverIndex().cover(lon, lat)
 }
 
 // Transform transforms coordinates from one EPSG-Code to another.
@@ -2,6 +2,13 @@
 package wgs84_test
 
 import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
 	"testing"
 
 	"github.com/wroge/wgs84"
@@ -59,3 +66,265 @@ func Test2(t *testing.T) {
 		t.Fatal("Failed (2)")
 	}
 }
+
+func TestCompound(t *testing.T) {
+	t.Parallel()
+
+	epsg := wgs84.EPSG()
+	epsg.AddVertical(7837, wgs84.VerticalOffset{Offset: 40})
+
+	crs, err := epsg.Lookup("25832+7837")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	east, north, h := wgs84.To(crs).Round(2)(9, 52, 140)
+	if east != 500000 || north != 5761038.21 || h != 100 {
+		t.Fatal("Failed", east, north, h)
+	}
+
+	lon, lat, h := wgs84.From(crs).Round(3)(east, north, h)
+	if lon != 9 || lat != 52 || h != 140 {
+		t.Fatal("Failed (2)", lon, lat, h)
+	}
+
+	if _, err := epsg.Lookup("25832+5783"); !errors.Is(err, wgs84.ErrNoCoordinateReferenceSystem) {
+		t.Fatal("Failed (3)", err)
+	}
+
+	if _, err := epsg.Lookup("EPSG+"); !errors.Is(err, wgs84.ErrInvalidCode) {
+		t.Fatal("Failed (4)", err)
+	}
+}
+
+func TestBuiltinVertical(t *testing.T) {
+	t.Parallel()
+
+	epsg := wgs84.EPSG()
+
+	for _, c := range []int{5773, 3855, 7837, 5703} {
+		if _, ok := epsg.Vertical(c).(wgs84.VerticalDatum); !ok {
+			t.Fatal("Failed", c)
+		}
+	}
+
+	if os.Getenv("PROJ_DATA") == "" && os.Getenv("PROJ_LIB") == "" {
+		if _, err := epsg.Lookup("EPSG:25832+7837"); !errors.Is(err, wgs84.ErrGeoidGrid) || !errors.Is(err, os.ErrNotExist) {
+			t.Fatal("Failed (2)", err)
+		}
+
+		if epsg.Compound(25832, 7837) != nil {
+			t.Fatal("Failed (3)")
+		}
+	}
+
+	var buf bytes.Buffer
+
+	_ = binary.Write(&buf, binary.BigEndian, []float64{45, 5, 10, 10})
+	_ = binary.Write(&buf, binary.BigEndian, []int32{2, 2})
+	_ = binary.Write(&buf, binary.BigEndian, []float32{40, 40, 40, 40})
+
+	path := filepath.Join(t.TempDir(), "GCG2016.gtx")
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	epsg.AddVertical(7837, wgs84.VerticalDatum{Geoid: wgs84.GeoidFile(path)})
+
+	crs, err := epsg.Lookup("EPSG:25832+7837")
+	if err != nil {
+		t.Fatal("Failed (4)", err)
+	}
+
+	if east, north, h := wgs84.To(crs).Round(2)(9, 52, 140); east != 500000 || north != 5761038.21 || h != 100 {
+		t.Fatal("Failed (5)", east, north, h)
+	}
+}
+
+func TestNames(t *testing.T) {
+	t.Parallel()
+
+	epsg := wgs84.EPSG()
+
+	if epsg.Name(25832) != "ETRS89 / UTM zone 32N" || epsg.Name(2132) != "NZGD2000 / Bluff 2000" {
+		t.Fatal("Failed", epsg.Name(25832), epsg.Name(2132))
+	}
+
+	if code, ok := epsg.ByName("ETRS89 / UTM zone 32N"); !ok || code != 25832 {
+		t.Fatal("Failed (2)", code)
+	}
+
+	if _, ok := epsg.ByName("etrs89 / utm zone 32n"); ok {
+		t.Fatal("Failed (3)")
+	}
+
+	if code, ok := epsg.ByAlias("british national grid"); !ok || code != 27700 {
+		t.Fatal("Failed (4)", code)
+	}
+
+	if code, ok := epsg.ByAlias("Google Mercator"); !ok || code != 3857 {
+		t.Fatal("Failed (5)", code)
+	}
+
+	results := epsg.Search("etrs89 utm 32")
+	if len(results) == 0 || results[0].Code != 25832 {
+		t.Fatal("Failed (6)", results)
+	}
+
+	results = epsg.Search("Britsh Natonal Grid")
+	if len(results) == 0 || results[0].Code != 27700 {
+		t.Fatal("Failed (7)", results)
+	}
+
+	results = epsg.Search("4326")
+	if len(results) == 0 || results[0].Code != 4326 || results[0].Score != 1 {
+		t.Fatal("Failed (8)", results)
+	}
+
+	if len(epsg.Search("")) != 0 || len(epsg.Search("xyzzy")) != 0 {
+		t.Fatal("Failed (9)")
+	}
+
+	for _, c := range epsg.Codes() {
+		if epsg.Name(c) == "" {
+			t.Fatal("Failed (10)", c)
+		}
+	}
+
+	epsg.Add(1000, wgs84.LonLat())
+
+	results = epsg.Search("1000")
+	if len(results) == 0 || results[0].Code != 1000 || results[0].Score != 1 {
+		t.Fatal("Failed (11)", results)
+	}
+
+	epsg.AddName(7837, "DHHN2016 height", "DHHN2016")
+
+	if code, ok := epsg.ByAlias("dhhn2016"); !ok || code != 7837 || len(epsg.Aliases(7837)) != 1 {
+		t.Fatal("Failed (12)", code)
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{
+		"4326",
+		"EPSG:4326",
+		"epsg::4326",
+		"urn:ogc:def:crs:EPSG::4326",
+		"urn:ogc:def:crs:EPSG:9.9.1:4326",
+		"urn:x-ogc:def:crs:EPSG:4326",
+		"http://www.opengis.net/def/crs/EPSG/0/4326",
+		"http://www.opengis.net/gml/srs/epsg.xml#4326",
+		"https://epsg.io/4326",
+		"https://spatialreference.org/ref/epsg/4326/",
+	} {
+		id, err := wgs84.ParseIdentifier(s)
+		if err != nil || id.String() != "EPSG:4326" || id.URN() != "urn:ogc:def:crs:EPSG::4326" {
+			t.Fatal("Failed", s, id, err)
+		}
+	}
+
+	for _, s := range []string{"", "EPSG:", "EPSG:LAMB93", "urn:ogc:def:crs", "https://example.com/"} {
+		if _, err := wgs84.ParseIdentifier(s); !errors.Is(err, wgs84.ErrInvalidCode) {
+			t.Fatal("Failed (2)", s, err)
+		}
+	}
+
+	epsg := wgs84.EPSG()
+
+	if err := epsg.AddIdentifier("ACME:4326", wgs84.UTM(32, true)); err != nil {
+		t.Fatal(err)
+	}
+
+	lamb93, err := epsg.Lookup("IGNF:lamb93")
+	if err != nil {
+		t.Fatal("Failed (3)", err)
+	}
+
+	x, y, _ := wgs84.To(lamb93).Round(3)(2, 46, 0)
+	e, n, _ := epsg.Transform(4326, 2154).Round(3)(2, 46, 0)
+
+	if x != e || y != n {
+		t.Fatal("Failed (4)", x, y, e, n)
+	}
+
+	acme, err := epsg.Lookup("urn:ogc:def:crs:ACME::4326")
+	if err != nil {
+		t.Fatal("Failed (5)", err)
+	}
+
+	if x, _, _ := wgs84.To(acme).Round(3)(9, 52, 0); x != 500000 {
+		t.Fatal("Failed (6)", x)
+	}
+
+	for _, s := range []string{"ESRI:102100", "http://www.opengis.net/def/crs/OGC/1.3/CRS84"} {
+		if _, err := epsg.Lookup(s); err != nil {
+			t.Fatal("Failed (7)", s, err)
+		}
+	}
+
+	if _, err := epsg.Lookup("ACME:1"); !errors.Is(err, wgs84.ErrNoCoordinateReferenceSystem) {
+		t.Fatal("Failed (8)", err)
+	}
+
+	epsg.AddVertical(7837, wgs84.VerticalOffset{Offset: 40})
+
+	for _, s := range []string{
+		"EPSG:25832+EPSG:7837",
+		"urn:ogc:def:crs,crs:EPSG::25832,crs:EPSG::7837",
+		"http://www.opengis.net/def/crs-compound?1=http://www.opengis.net/def/crs/EPSG/0/25832&2=http://www.opengis.net/def/crs/EPSG/0/7837",
+	} {
+		if _, err := epsg.Lookup(s); err != nil {
+			t.Fatal("Failed (9)", s, err)
+		}
+	}
+
+	ids := epsg.Identifiers()
+	if len(ids) != len(epsg.Codes())+8 || ids[0].Authority != "ACME" {
+		t.Fatal("Failed (10)", len(ids), ids[0])
+	}
+}
+
+func TestCodesCoverPoints(t *testing.T) {
+	t.Parallel()
+
+	epsg := wgs84.EPSG()
+	epsg.Add(1000, wgs84.NZGD2000().LonLat())
+	epsg.Add(1001, wgs84.ProjectedReferenceSystem{Datum: wgs84.WGS84(), Area: wgs84.AreaFunc(func(lon, lat float64) bool {
+		return lon > 0 && lat > 0
+	})})
+
+	var points [][2]float64
+
+	for lon := -180.0; lon <= 180; lon += 2.5 {
+		for lat := -90.0; lat <= 90; lat += 2.5 {
+			points = append(points, [2]float64{lon, lat})
+		}
+	}
+
+	points = append(points, [2]float64{-176.5, -43.85}, [2]float64{9, 52}, [2]float64{200, 0})
+
+	covers := epsg.CodesCoverPoints(points)
+
+	for i, p := range points {
+		var want []int
+
+		for _, c := range epsg.Codes() {
+			if epsg.Code(c).Contains(p[0], p[1]) {
+				want = append(want, c)
+			}
+		}
+
+		sort.Ints(want)
+
+		if fmt.Sprint(want) != fmt.Sprint(covers[i]) || fmt.Sprint(want) != fmt.Sprint(epsg.CodesCover(p[0], p[1])) {
+			t.Fatal("Failed", p, want, covers[i])
+		}
+	}
+
+	if c := epsg.CodesCover(-176.5, -43.85); len(c) == 0 || c[0] != 1000 {
+		t.Fatal("Failed (2)", c)
+	}
+}
@@ -0,0 +1,363 @@
+//nolint:varnamelen,nonamedreturns,gomnd
+package wgs84
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrControlPoints is returned if there are too few or mismatching
+	// control points for a parameter estimation.
+	ErrControlPoints = errors.New("not enough control points")
+	// ErrSingular is returned if the control points can't determine the
+	// parameters of a transformation.
+	ErrSingular = errors.New("control points are degenerate")
+)
+
+// HelmertEstimate holds the least-squares estimation of the 7 parameters of
+// a Helmert-Transformation in the units of the Helmert function: meters,
+// arc-seconds and ppm.
+type HelmertEstimate struct {
+	Tx, Ty, Tz, Rx, Ry, Rz, Ds float64
+	// StdDev holds the standard deviations of the parameters in the order
+	// Tx, Ty, Tz, Rx, Ry, Rz, Ds.
+	StdDev [7]float64
+	// Residuals holds the differences of the target points and the
+	// transformed source points.
+	Residuals [][3]float64
+	// RMS is the root mean square of the 3D residuals.
+	RMS float64
+}
+
+// EstimateHelmert estimates the parameters of a 7-parameter-Helmert-
+// Transformation from geocentric coordinates of at least 3 control points
+// in a local datum (from) and in WGS84 (to).
+func EstimateHelmert(from, to [][3]float64) (HelmertEstimate, error) {
+	n := len(from)
+	if n < 3 || n != len(to) {
+		return HelmertEstimate{}, ErrControlPoints
+	}
+
+	var cf, ct [3]float64
+
+	for i := range from {
+		for k := 0; k < 3; k++ {
+			cf[k] += from[i][k] / float64(n)
+			ct[k] += to[i][k] / float64(n)
+		}
+	}
+
+	var (
+		p    [7]float64
+		q    [][]float64
+		err  error
+		rows = make([][]float64, 3*n)
+		obs  = make([]float64, 3*n)
+	)
+
+	for iteration := 0; iteration < 10; iteration++ {
+		for i := range from {
+			u := [3]float64{from[i][0] - cf[0], from[i][1] - cf[1], from[i][2] - cf[2]}
+			x0, y0, z0 := calcHelmert(u[0], u[1], u[2], p[0], p[1], p[2], p[3], p[4], p[5], p[6])
+
+			obs[3*i] = to[i][0] - ct[0] - x0
+			obs[3*i+1] = to[i][1] - ct[1] - y0
+			obs[3*i+2] = to[i][2] - ct[2] - z0
+
+			j := helmertJacobian(u, p)
+			rows[3*i], rows[3*i+1], rows[3*i+2] = j[0][:], j[1][:], j[2][:]
+		}
+
+		var dp []float64
+
+		dp, q, err = leastSquares(rows, obs)
+		if err != nil {
+			return HelmertEstimate{}, err
+		}
+
+		converged := true
+
+		for k := range p {
+			p[k] += dp[k]
+			if math.Abs(dp[k]) > 1e-10 {
+				converged = false
+			}
+		}
+
+		if converged {
+			break
+		}
+	}
+
+	// Move the translation from the centroids back to the geocentric origin.
+	x0, y0, z0 := calcHelmert(cf[0], cf[1], cf[2], 0, 0, 0, p[3], p[4], p[5], p[6])
+	e := HelmertEstimate{
+		Tx:        ct[0] + p[0] - x0,
+		Ty:        ct[1] + p[1] - y0,
+		Tz:        ct[2] + p[2] - z0,
+		Rx:        p[3],
+		Ry:        p[4],
+		Rz:        p[5],
+		Ds:        p[6],
+		Residuals: make([][3]float64, n),
+	}
+
+	var vv float64
+
+	for i := range from {
+		x, y, z := calcHelmert(from[i][0], from[i][1], from[i][2], e.Tx, e.Ty, e.Tz, e.Rx, e.Ry, e.Rz, e.Ds)
+		e.Residuals[i] = [3]float64{to[i][0] - x, to[i][1] - y, to[i][2] - z}
+		vv += e.Residuals[i][0]*e.Residuals[i][0] + e.Residuals[i][1]*e.Residuals[i][1] +
+			e.Residuals[i][2]*e.Residuals[i][2]
+	}
+
+	e.RMS = math.Sqrt(vv / float64(n))
+
+	jc := helmertJacobian(cf, [7]float64{0, 0, 0, p[3], p[4], p[5], p[6]})
+	a := make([][]float64, 7)
+
+	for k := range a {
+		a[k] = make([]float64, 7)
+		a[k][k] = 1
+
+		if k < 3 {
+			for l := 3; l < 7; l++ {
+				a[k][l] = -jc[k][l]
+			}
+		}
+	}
+
+	e.StdDev = [7]float64(standardDeviations(a, q, vv, 3*n-7))
+
+	return e, nil
+}
+
+// Datum provides a Datum with the estimated parameters similar to Helmert.
+func (e HelmertEstimate) Datum(a, fi float64) Datum {
+	return Helmert(a, fi, e.Tx, e.Ty, e.Tz, e.Rx, e.Ry, e.Rz, e.Ds)
+}
+
+// helmertJacobian returns the partial derivatives of calcHelmert at u with
+// respect to tx, ty, tz, rx, ry, rz and ds.
+func helmertJacobian(u [3]float64, p [7]float64) [3][7]float64 {
+	m := 1 + p[6]*ppm
+
+	return [3][7]float64{
+		{1, 0, 0, 0, m * u[2] * asec, -m * u[1] * asec, ppm * (u[0] + u[2]*p[4]*asec - u[1]*p[5]*asec)},
+		{0, 1, 0, -m * u[2] * asec, 0, m * u[0] * asec, ppm * (u[1] + u[0]*p[5]*asec - u[2]*p[3]*asec)},
+		{0, 0, 1, m * u[1] * asec, -m * u[0] * asec, 0, ppm * (u[2] + u[1]*p[3]*asec - u[0]*p[4]*asec)},
+	}
+}
+
+// Helmert2DEstimate holds the least-squares estimation of the 4 parameters
+// of a 2D-Helmert-(Similarity-)Transformation. Rotation is counter-clockwise
+// in degrees and Scale is a factor.
+//
+// It implements the PlanarTransformation interface.
+type Helmert2DEstimate struct {
+	Tx, Ty, Rotation, Scale float64
+	// StdDev holds the standard deviations of the parameters in the order
+	// Tx, Ty, Rotation, Scale.
+	StdDev [4]float64
+	// Residuals holds the differences of the target points and the
+	// transformed source points.
+	Residuals [][2]float64
+	// RMS is the root mean square of the 2D residuals.
+	RMS float64
+}
+
+// EstimateHelmert2D estimates the parameters of a 4-parameter-Helmert-
+// Transformation from planar coordinates of at least 2 control points in a
+// local system (from) and a target system (to).
+func EstimateHelmert2D(from, to [][2]float64) (Helmert2DEstimate, error) {
+	n := len(from)
+	if n < 2 || n != len(to) {
+		return Helmert2DEstimate{}, ErrControlPoints
+	}
+
+	var cf, ct [2]float64
+
+	for i := range from {
+		for k := 0; k < 2; k++ {
+			cf[k] += from[i][k] / float64(n)
+			ct[k] += to[i][k] / float64(n)
+		}
+	}
+
+	rows := make([][]float64, 2*n)
+	obs := make([]float64, 2*n)
+
+	for i := range from {
+		u, v := from[i][0]-cf[0], from[i][1]-cf[1]
+		rows[2*i] = []float64{1, 0, u, -v}
+		rows[2*i+1] = []float64{0, 1, v, u}
+		obs[2*i] = to[i][0] - ct[0]
+		obs[2*i+1] = to[i][1] - ct[1]
+	}
+
+	p, q, err := leastSquares(rows, obs)
+	if err != nil {
+		return Helmert2DEstimate{}, err
+	}
+
+	a, b := p[2], p[3]
+	m := math.Hypot(a, b)
+	e := Helmert2DEstimate{
+		Tx:        ct[0] + p[0] - (a*cf[0] - b*cf[1]),
+		Ty:        ct[1] + p[1] - (b*cf[0] + a*cf[1]),
+		Rotation:  degree(math.Atan2(b, a)),
+		Scale:     m,
+		Residuals: make([][2]float64, n),
+	}
+
+	var vv float64
+
+	for i := range from {
+		x, y := e.Forward(from[i][0], from[i][1])
+		e.Residuals[i] = [2]float64{to[i][0] - x, to[i][1] - y}
+		vv += e.Residuals[i][0]*e.Residuals[i][0] + e.Residuals[i][1]*e.Residuals[i][1]
+	}
+
+	e.RMS = math.Sqrt(vv / float64(n))
+
+	jac := [][]float64{
+		{1, 0, -cf[0], cf[1]},
+		{0, 1, -cf[1], -cf[0]},
+		{0, 0, degree(-b / (m * m)), degree(a / (m * m))},
+		{0, 0, a / m, b / m},
+	}
+
+	e.StdDev = [4]float64(standardDeviations(jac, q, vv, 2*n-4))
+
+	return e, nil
+}
+
+// Forward transforms local coordinates to the target system.
+func (e Helmert2DEstimate) Forward(x, y float64) (x0, y0 float64) {
+	s, c := math.Sincos(radian(e.Rotation))
+
+	return e.Scale*(c*x-s*y) + e.Tx, e.Scale*(s*x+c*y) + e.Ty
+}
+
+// Inverse transforms coordinates of the target system to local coordinates.
+func (e Helmert2DEstimate) Inverse(x0, y0 float64) (x, y float64) {
+	s, c := math.Sincos(radian(e.Rotation))
+	x0, y0 = x0-e.Tx, y0-e.Ty
+
+	return (c*x0 + s*y0) / e.Scale, (c*y0 - s*x0) / e.Scale
+}
+
+// leastSquares solves the linear observation equations rows * x = obs and
+// returns the solution and the inverse of the normal matrix.
+func leastSquares(rows [][]float64, obs []float64) (x []float64, q [][]float64, err error) {
+	u := len(rows[0])
+	n := make([][]float64, u)
+	b := make([]float64, u)
+
+	for k := range n {
+		n[k] = make([]float64, u)
+	}
+
+	for i, row := range rows {
+		for k := 0; k < u; k++ {
+			b[k] += row[k] * obs[i]
+
+			for l := 0; l < u; l++ {
+				n[k][l] += row[k] * row[l]
+			}
+		}
+	}
+
+	q, err = invert(n)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	x = make([]float64, u)
+
+	for k := 0; k < u; k++ {
+		for l := 0; l < u; l++ {
+			x[k] += q[k][l] * b[l]
+		}
+	}
+
+	return x, q, nil
+}
+
+// invert inverts a square matrix through Gauss-Jordan elimination with
+// partial pivoting.
+func invert(m [][]float64) ([][]float64, error) {
+	n := len(m)
+	a := make([][]float64, n)
+	scale := 0.0
+
+	for i := range m {
+		a[i] = make([]float64, 2*n)
+		copy(a[i], m[i])
+		a[i][n+i] = 1
+		scale = math.Max(scale, math.Abs(m[i][i]))
+	}
+
+	for c := 0; c < n; c++ {
+		pivot := c
+
+		for r := c + 1; r < n; r++ {
+			if math.Abs(a[r][c]) > math.Abs(a[pivot][c]) {
+				pivot = r
+			}
+		}
+
+		if math.Abs(a[pivot][c]) <= 1e-14*scale {
+			return nil, ErrSingular
+		}
+
+		a[c], a[pivot] = a[pivot], a[c]
+
+		for k := 2*n - 1; k >= c; k-- {
+			a[c][k] /= a[c][c]
+		}
+
+		for r := 0; r < n; r++ {
+			if r == c || a[r][c] == 0 {
+				continue
+			}
+
+			f := a[r][c]
+			for k := c; k < 2*n; k++ {
+				a[r][k] -= f * a[c][k]
+			}
+		}
+	}
+
+	for i := range a {
+		a[i] = a[i][n:]
+	}
+
+	return a, nil
+}
+
+// standardDeviations propagates the cofactor matrix q through the jacobian
+// j and scales it with the variance of unit weight.
+func standardDeviations(j, q [][]float64, vv float64, redundancy int) []float64 {
+	sd := make([]float64, len(j))
+	if redundancy <= 0 {
+		return sd
+	}
+
+	s0 := vv / float64(redundancy)
+
+	for k := range j {
+		var v float64
+
+		for l := range q {
+			for m := range q {
+				v += j[k][l] * q[l][m] * j[k][m]
+			}
+		}
+
+		sd[k] = math.Sqrt(s0 * v)
+	}
+
+	return sd
+}
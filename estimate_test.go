@@ -0,0 +1,66 @@
+//nolint:varnamelen
+package wgs84_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestEstimateHelmert(t *testing.T) {
+	t.Parallel()
+
+	airy := wgs84.Datum{Spheroid: wgs84.Airy{}}
+
+	var from, to [][3]float64
+
+	for _, ll := range [][2]float64{{-5, 50}, {1, 51}, {-3, 55}, {-1, 53}, {-6, 58}} {
+		x, y, z := airy.LonLat().To(airy.XYZ())(ll[0], ll[1], 0)
+		x0, y0, z0 := wgs84.OSGB36().XYZ().To(wgs84.XYZ())(x, y, z)
+
+		from = append(from, [3]float64{x, y, z})
+		to = append(to, [3]float64{x0, y0, z0})
+	}
+
+	e, err := wgs84.EstimateHelmert(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(e.Tx-446.448) > 0.001 || math.Abs(e.Ty+125.157) > 0.001 || math.Abs(e.Tz-542.06) > 0.001 ||
+		math.Abs(e.Rx-0.15) > 1e-5 || math.Abs(e.Ry-0.247) > 1e-5 || math.Abs(e.Rz-0.842) > 1e-5 ||
+		math.Abs(e.Ds+20.489) > 1e-5 || e.RMS > 1e-6 {
+		t.Fatal("Failed", e)
+	}
+
+	if _, err := wgs84.EstimateHelmert(from[:2], to[:2]); !errors.Is(err, wgs84.ErrControlPoints) {
+		t.Fatal("Failed (2)", err)
+	}
+}
+
+func TestEstimateHelmert2D(t *testing.T) {
+	t.Parallel()
+
+	from := [][2]float64{{0, 0}, {100, 0}, {100, 100}, {0, 100}}
+	to := [][2]float64{{500000, 5700000}, {500099.99, 5700001.75}, {500098.25, 5700101.75}, {499998.25, 5700100.01}}
+
+	e, err := wgs84.EstimateHelmert2D(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(e.Rotation-1) > 0.01 || math.Abs(e.Scale-1) > 0.001 || e.RMS > 0.01 || e.StdDev[0] > 0.01 {
+		t.Fatal("Failed", e)
+	}
+
+	x, y := e.Inverse(e.Forward(50, 50))
+	if math.Abs(x-50) > 1e-9 || math.Abs(y-50) > 1e-9 {
+		t.Fatal("Failed (2)", x, y)
+	}
+
+	if _, err := wgs84.EstimateHelmert2D([][2]float64{{1, 1}, {1, 1}}, to[:2]); !errors.Is(err, wgs84.ErrSingular) {
+		t.Fatal("Failed (3)", err)
+	}
+}
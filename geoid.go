@@ -0,0 +1,327 @@
+//nolint:varnamelen,gomnd
+package wgs84
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrGeoidGrid is returned for unreadable or unsupported geoid grid files.
+var ErrGeoidGrid = errors.New("invalid geoid grid")
+
+// GeoidGrid is a regular longitude/latitude grid of geoid undulations.
+//
+// The Values are stored row by row from south to north and within a row
+// from west to east. NaN values mark missing data.
+type GeoidGrid struct {
+	West, South, DLon, DLat float64
+	Columns, Rows           int
+	Values                  []float32
+}
+
+// Undulation returns the bilinear interpolated geoid undulation.
+//
+// Returns NaN outside of the grid.
+func (g *GeoidGrid) Undulation(lon, lat float64) float64 {
+	if g == nil || g.Columns < 2 || g.Rows < 2 || len(g.Values) < g.Columns*g.Rows {
+		return math.NaN()
+	}
+
+	global := float64(g.Columns)*g.DLon >= 360-1e-9
+
+	x := math.Mod(lon-g.West, 360)
+	if x < 0 {
+		x += 360
+	}
+
+	x /= g.DLon
+	y := (lat - g.South) / g.DLat
+
+	if y < 0 || y > float64(g.Rows-1) || (!global && x > float64(g.Columns-1)) {
+		return math.NaN()
+	}
+
+	c, r := int(x), int(y)
+	if r == g.Rows-1 {
+		r--
+	}
+
+	if !global && c == g.Columns-1 {
+		c--
+	}
+
+	c1 := c + 1
+	if c1 >= g.Columns {
+		c1 -= g.Columns
+	}
+
+	fx, fy := x-float64(c), y-float64(r)
+	n := 0.0
+
+	for _, p := range [4]struct {
+		i int
+		w float64
+	}{
+		{r*g.Columns + c, (1 - fx) * (1 - fy)},
+		{r*g.Columns + c1, fx * (1 - fy)},
+		{(r+1)*g.Columns + c, (1 - fx) * fy},
+		{(r+1)*g.Columns + c1, fx * fy},
+	} {
+		if p.w != 0 {
+			n += p.w * float64(g.Values[p.i])
+		}
+	}
+
+	return n
+}
+
+// LoadGeoidGrid reads a geoid grid file from a local path. The format is
+// chosen by the file extension: .gtx, .bin (NOAA) or .grd (NGA EGM).
+func LoadGeoidGrid(path string) (*GeoidGrid, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".gtx":
+		return ReadGTX(r)
+	case ".bin":
+		return ReadNOAABin(r)
+	case ".grd":
+		return ReadGRD(r)
+	}
+
+	return nil, fmt.Errorf("%w: unknown extension of %s", ErrGeoidGrid, path)
+}
+
+// GeoidFile returns a Geoid that loads a geoid grid file on first use. A
+// relative path is searched in the directories of the PROJ_DATA and PROJ_LIB
+// environment variables and then in the working directory.
+//
+// The undulation is NaN if the file can't be loaded. Repository.Lookup
+// returns the error of loading the file for vertical systems using it.
+func GeoidFile(path string) Geoid {
+	return &geoidFile{path: path}
+}
+
+type geoidFile struct {
+	path string
+	once sync.Once
+	grid *GeoidGrid
+	err  error
+}
+
+func (g *geoidFile) Undulation(lon, lat float64) float64 {
+	if g.load() != nil {
+		return math.NaN()
+	}
+
+	return g.grid.Undulation(lon, lat)
+}
+
+// load reads the grid on first use and returns the error of the last
+// searched path if no path can be read.
+func (g *geoidFile) load() error {
+	g.once.Do(func() {
+		paths := []string{g.path}
+
+		if !filepath.IsAbs(g.path) {
+			paths = nil
+
+			for _, env := range []string{"PROJ_DATA", "PROJ_LIB"} {
+				for _, dir := range filepath.SplitList(os.Getenv(env)) {
+					paths = append(paths, filepath.Join(dir, g.path))
+				}
+			}
+
+			paths = append(paths, g.path)
+		}
+
+		for _, path := range paths {
+			grid, err := LoadGeoidGrid(path)
+			if err == nil {
+				g.grid, g.err = grid, nil
+
+				return
+			}
+
+			g.err = fmt.Errorf("%w: %s: %w", ErrGeoidGrid, g.path, err)
+		}
+	})
+
+	return g.err
+}
+
+// ReadGTX reads a geoid grid in the big-endian GTX format.
+func ReadGTX(r io.Reader) (*GeoidGrid, error) {
+	var header struct {
+		South, West, DLat, DLon float64
+		Rows, Columns           int32
+	}
+
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGeoidGrid, err)
+	}
+
+	return readGrid(r, binary.BigEndian, header.West, header.South, header.DLon, header.DLat,
+		int(header.Columns), int(header.Rows), -88.8888)
+}
+
+// ReadNOAABin reads a geoid grid in the NOAA .bin format as used by the
+// GEOID12B or GEOID18 models. Both byte orders are supported.
+func ReadNOAABin(r io.Reader) (*GeoidGrid, error) {
+	var header struct {
+		South, West, DLat, DLon float64
+		Rows, Columns, Kind     int32
+	}
+
+	raw := make([]byte, binary.Size(header))
+	if _, err := io.ReadFull(r, raw); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGeoidGrid, err)
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+
+	for _, order = range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		if err := binary.Read(bytes.NewReader(raw), order, &header); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrGeoidGrid, err)
+		}
+
+		if header.Kind == 1 {
+			break
+		}
+	}
+
+	if header.Kind != 1 {
+		return nil, fmt.Errorf("%w: unsupported data kind %d", ErrGeoidGrid, header.Kind)
+	}
+
+	return readGrid(r, order, header.West, header.South, header.DLon, header.DLat,
+		int(header.Columns), int(header.Rows), -88.8888)
+}
+
+func readGrid(r io.Reader, order binary.ByteOrder, west, south, dlon, dlat float64,
+	columns, rows int, nodata float32,
+) (*GeoidGrid, error) {
+	if columns < 2 || rows < 2 || dlon <= 0 || dlat <= 0 {
+		return nil, fmt.Errorf("%w: invalid header", ErrGeoidGrid)
+	}
+
+	if west >= 180 {
+		west -= 360
+	}
+
+	g := &GeoidGrid{
+		West:    west,
+		South:   south,
+		DLon:    dlon,
+		DLat:    dlat,
+		Columns: columns,
+		Rows:    rows,
+		Values:  make([]float32, columns*rows),
+	}
+
+	if err := binary.Read(r, order, g.Values); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGeoidGrid, err)
+	}
+
+	for i, v := range g.Values {
+		if v == nodata {
+			g.Values[i] = float32(math.NaN())
+		}
+	}
+
+	return g, nil
+}
+
+// ReadGRD reads a geoid grid in the ASCII format of the NGA as used by the
+// EGM96 (WW15MGH.GRD) or EGM2008 grids.
+//
+// The header holds south, north, west, east, dlat and dlon in degrees. The
+// values follow row by row from north to south.
+func ReadGRD(r io.Reader) (*GeoidGrid, error) {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 1<<16), 1<<24)
+	s.Split(bufio.ScanWords)
+
+	var (
+		header [6]float64
+		values []float32
+	)
+
+	for i := 0; s.Scan(); i++ {
+		v, err := strconv.ParseFloat(s.Text(), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrGeoidGrid, err)
+		}
+
+		if i < len(header) {
+			header[i] = v
+
+			continue
+		}
+
+		values = append(values, float32(v))
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGeoidGrid, err)
+	}
+
+	south, north, west, east, dlat, dlon := header[0], header[1], header[2], header[3], header[4], header[5]
+	if dlat <= 0 || dlon <= 0 {
+		return nil, fmt.Errorf("%w: invalid header", ErrGeoidGrid)
+	}
+
+	rows := int(math.Round((north-south)/dlat)) + 1
+	columns := int(math.Round((east-west)/dlon)) + 1
+
+	if rows < 2 || columns < 2 || len(values) != rows*columns {
+		return nil, fmt.Errorf("%w: expected %d values, got %d", ErrGeoidGrid, rows*columns, len(values))
+	}
+
+	// A global grid repeats the first column at 360°.
+	if float64(columns-1)*dlon >= 360-1e-9 {
+		columns--
+
+		for r := 0; r < rows; r++ {
+			copy(values[r*columns:], values[r*(columns+1):r*(columns+1)+columns])
+		}
+
+		values = values[:rows*columns]
+	}
+
+	if west >= 180 {
+		west -= 360
+	}
+
+	g := &GeoidGrid{
+		West:    west,
+		South:   south,
+		DLon:    dlon,
+		DLat:    dlat,
+		Columns: columns,
+		Rows:    rows,
+		Values:  make([]float32, rows*columns),
+	}
+
+	for r := 0; r < rows; r++ {
+		copy(g.Values[(rows-1-r)*columns:], values[r*columns:(r+1)*columns])
+	}
+
+	return g, nil
+}
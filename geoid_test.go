@@ -0,0 +1,82 @@
+//nolint:varnamelen
+package wgs84_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestGeoidGrid(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	_ = binary.Write(&buf, binary.BigEndian, []float64{50, 5, 1, 1})
+	_ = binary.Write(&buf, binary.BigEndian, []int32{2, 3})
+	_ = binary.Write(&buf, binary.BigEndian, []float32{40, 42, 44, 46, 48, -88.8888})
+
+	gtx, err := wgs84.ReadGTX(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := gtx.Undulation(5.5, 50.5); n != 44 {
+		t.Fatal("Failed", n)
+	}
+
+	if n := gtx.Undulation(6.5, 50.5); !math.IsNaN(n) {
+		t.Fatal("Failed (2)", n)
+	}
+
+	grd, err := wgs84.ReadGRD(strings.NewReader(`-90 90 0 360 90 180
+		10 10 10
+		20 30 20
+		5 5 5`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := grd.Undulation(-90, 0); n != 25 {
+		t.Fatal("Failed (3)", n)
+	}
+
+	navd := wgs84.VerticalDatum{Geoid: gtx}
+
+	if h := navd.ToEllipsoidal(5, 50, 100); h != 140 {
+		t.Fatal("Failed (4)", h)
+	}
+
+	if h := navd.FromEllipsoidal(6, 51, 148); h != 100 || navd.Contains(6, 51.5) {
+		t.Fatal("Failed (5)", h)
+	}
+}
+
+func TestGeoidFile(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	_ = binary.Write(&buf, binary.BigEndian, []float64{50, 5, 1, 1})
+	_ = binary.Write(&buf, binary.BigEndian, []int32{2, 2})
+	_ = binary.Write(&buf, binary.BigEndian, []float32{40, 42, 44, 46})
+
+	path := filepath.Join(t.TempDir(), "geoid.gtx")
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := wgs84.GeoidFile(path).Undulation(5, 50); n != 40 {
+		t.Fatal("Failed", n)
+	}
+
+	if n := wgs84.GeoidFile(filepath.Join(t.TempDir(), "missing.gtx")).Undulation(5, 50); !math.IsNaN(n) {
+		t.Fatal("Failed (2)", n)
+	}
+}
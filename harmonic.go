@@ -0,0 +1,293 @@
+//nolint:varnamelen,gomnd
+package wgs84
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// HarmonicGeoid is a geopotential model of fully normalized spherical
+// harmonic coefficients like EGM96 or EGM2008.
+//
+// The geoid undulation is synthesized up to the Degree relative to the WGS84
+// normal gravity field. The coefficients are stored in triangular order
+// n*(n+1)/2+m.
+//
+// It implements the Geoid interface and can be used as the Geoid of a
+// VerticalDatum.
+type HarmonicGeoid struct {
+	// GM and A are the constants of the model. By default the WGS84 values
+	// 3.986004418e14 and 6378137 are used.
+	GM, A float64
+
+	// Degree is the maximum degree of the synthesis.
+	Degree int
+
+	// C and S are the fully normalized coefficients.
+	C, S []float64
+
+	// Zero is the zero degree term, -0.53 for EGM96 and -0.41 for EGM2008.
+	Zero float64
+
+	// Correction is an optional Geoid added to the height anomaly, for
+	// example the zeta-to-N correction of EGM2008.
+	Correction Geoid
+}
+
+// LoadHarmonicGeoid reads a coefficient file from a local path up to the
+// maximum degree. A maximum degree <= 0 reads all coefficients.
+func LoadHarmonicGeoid(path string, degree int) (*HarmonicGeoid, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadHarmonicGeoid(f, degree)
+}
+
+// ReadHarmonicGeoid reads coefficients in the format of the EGM96 and
+// EGM2008 coefficient files. Each line contains the degree, the order and
+// the C and S coefficients, optionally followed by their standard
+// deviations. Fortran exponents like 0.1D-05 are accepted.
+//
+// A maximum degree <= 0 reads all coefficients.
+func ReadHarmonicGeoid(r io.Reader, degree int) (*HarmonicGeoid, error) {
+	h := &HarmonicGeoid{}
+	scanner := bufio.NewScanner(r)
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		fields := strings.Fields(strings.NewReplacer("D", "E", "d", "e").Replace(scanner.Text()))
+		if len(fields) == 0 {
+			continue
+		}
+
+		if len(fields) < 4 {
+			return nil, fmt.Errorf("%w: line %d", ErrGeoidGrid, line)
+		}
+
+		n, err1 := strconv.Atoi(fields[0])
+		m, err2 := strconv.Atoi(fields[1])
+		c, err3 := strconv.ParseFloat(fields[2], 64)
+		s, err4 := strconv.ParseFloat(fields[3], 64)
+
+		if err1 != nil || err2 != nil || err3 != nil || err4 != nil || m < 0 || m > n {
+			return nil, fmt.Errorf("%w: line %d", ErrGeoidGrid, line)
+		}
+
+		if degree > 0 && n > degree {
+			continue
+		}
+
+		i := n*(n+1)/2 + m
+		for len(h.C) <= i {
+			h.C = append(h.C, 0)
+			h.S = append(h.S, 0)
+		}
+
+		h.C[i], h.S[i] = c, s
+
+		if n > h.Degree {
+			h.Degree = n
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGeoidGrid, err)
+	}
+
+	if len(h.C) == 0 {
+		return nil, fmt.Errorf("%w: no coefficients", ErrGeoidGrid)
+	}
+
+	for len(h.C) < (h.Degree+1)*(h.Degree+2)/2 {
+		h.C = append(h.C, 0)
+		h.S = append(h.S, 0)
+	}
+
+	return h, nil
+}
+
+// Undulation returns the geoid undulation at a WGS84 location.
+func (h *HarmonicGeoid) Undulation(lon, lat float64) float64 {
+	if h == nil {
+		return math.NaN()
+	}
+
+	return h.synthesis(lat).undulation(lon, h)
+}
+
+// Grid precomputes a GeoidGrid for a faster interpolation of undulations.
+// The bounds are aligned to the step in degrees.
+func (h *HarmonicGeoid) Grid(west, south, east, north, step float64) *GeoidGrid {
+	if h == nil || step <= 0 || east <= west || north <= south {
+		return nil
+	}
+
+	g := &GeoidGrid{
+		West:    west,
+		South:   south,
+		DLon:    step,
+		DLat:    step,
+		Columns: int(math.Ceil((east-west)/step-1e-9)) + 1,
+		Rows:    int(math.Ceil((north-south)/step-1e-9)) + 1,
+	}
+
+	if float64(g.Columns-1)*step >= 360-1e-9 {
+		g.Columns--
+	}
+
+	g.Values = make([]float32, g.Columns*g.Rows)
+
+	for r := 0; r < g.Rows; r++ {
+		row := h.synthesis(math.Min(south+float64(r)*step, 90))
+
+		for c := 0; c < g.Columns; c++ {
+			g.Values[r*g.Columns+c] = float32(row.undulation(west+float64(c)*step, h))
+		}
+	}
+
+	return g
+}
+
+const harmonicScale = 1e280
+
+// harmonicRow contains the longitude independent sums of a latitude.
+type harmonicRow struct {
+	a, b                []float64
+	u, factor, latitude float64
+}
+
+func (row harmonicRow) undulation(lon float64, h *HarmonicGeoid) float64 {
+	λ := radian(lon)
+	sum := 0.0
+
+	// Horner scheme in cos(φ).
+	for m := len(row.a) - 1; m >= 0; m-- {
+		sin, cos := math.Sincos(float64(m) * λ)
+		sum = sum*row.u + row.a[m]*cos + row.b[m]*sin
+	}
+
+	n := row.factor*sum + h.Zero
+
+	if h.Correction != nil {
+		n += h.Correction.Undulation(lon, row.latitude)
+	}
+
+	return n
+}
+
+func (h *HarmonicGeoid) synthesis(latitude float64) harmonicRow {
+	gm, a := h.GM, h.A
+	if gm == 0 {
+		gm = 3.986004418e14
+	}
+
+	if a == 0 {
+		a = A
+	}
+
+	size := len(h.C)
+	if len(h.S) < size {
+		size = len(h.S)
+	}
+
+	degree := h.Degree
+	if limit := int((math.Sqrt(float64(8*size+1)) - 3) / 2); degree > limit {
+		degree = limit
+	}
+
+	// point on the ellipsoid in geocentric coordinates
+	x, _, z := lonLatToXYZ(0, latitude, 0, A, Fi)
+	r := math.Hypot(x, z)
+	t, u := z/r, x/r
+	q := a / r
+
+	// normal gravity of Somigliana
+	s2 := sin2(radian(latitude))
+	γ := 9.7803253359 * (1 + 0.00193185265241*s2) / math.Sqrt(1-spheroid{a: A, fi: Fi}.e2()*s2)
+
+	row := harmonicRow{
+		a:        make([]float64, degree+1),
+		b:        make([]float64, degree+1),
+		u:        u,
+		factor:   gm / (r * γ) * harmonicScale,
+		latitude: latitude,
+	}
+
+	root := make([]float64, 2*degree+4)
+	for i := range root {
+		root[i] = math.Sqrt(float64(i))
+	}
+
+	qn := make([]float64, degree+1)
+	qn[0] = 1
+
+	for n := 1; n <= degree; n++ {
+		qn[n] = qn[n-1] * q
+	}
+
+	// pmm is the sectoral Legendre function divided by cos(φ)^m and scaled
+	// to avoid underflows at high degrees (Holmes and Featherstone, 2002).
+	pmm := 1 / harmonicScale
+
+	for m := 0; m <= degree; m++ {
+		switch m {
+		case 0:
+		case 1:
+			pmm *= root[3]
+		default:
+			pmm *= root[2*m+1] / root[2*m]
+		}
+
+		p1, p2 := pmm, 0.0
+
+		for n := m; n <= degree; n++ {
+			if n > m {
+				p := root[2*n-1] * root[2*n+1] / (root[n-m] * root[n+m]) * t * p1
+				if n > m+1 {
+					p -= root[2*n+1] * root[n+m-1] * root[n-m-1] / (root[n-m] * root[n+m] * root[2*n-3]) * p2
+				}
+
+				p1, p2 = p, p1
+			}
+
+			if n < 2 {
+				continue
+			}
+
+			i := n*(n+1)/2 + m
+			c := h.C[i]
+
+			if m == 0 && n%2 == 0 {
+				c -= normalZonal(n / 2)
+			}
+
+			row.a[m] += qn[n] * c * p1
+			row.b[m] += qn[n] * h.S[i] * p1
+		}
+	}
+
+	return row
+}
+
+// normalZonal returns the fully normalized even zonal coefficient of degree
+// 2n > 0 of the WGS84 normal gravity field.
+func normalZonal(n int) float64 {
+	const c20 = -0.484166774985e-3
+
+	e2 := spheroid{a: A, fi: Fi}.e2()
+	j2 := -c20 * math.Sqrt(5)
+	fn := float64(n)
+	j2n := math.Pow(-1, fn+1) * 3 * math.Pow(e2, fn) / ((2*fn + 1) * (2*fn + 3)) * (1 - fn + 5*fn*j2/e2)
+
+	return -j2n / math.Sqrt(4*fn+1)
+}
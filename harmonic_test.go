@@ -0,0 +1,53 @@
+//nolint:varnamelen
+package wgs84_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestHarmonicGeoid(t *testing.T) {
+	t.Parallel()
+
+	egm, err := wgs84.ReadHarmonicGeoid(strings.NewReader(`
+		2    0   -0.484166774985D-03    0.000000000000D+00    0.0D+00    0.0D+00
+		2    1    0.000000000000D+00    0.000000000000D+00    0.0D+00    0.0D+00
+		2    2    0.100000000000D-05    0.000000000000D+00    0.0D+00    0.0D+00
+		3    3    0.100000000000D-05    0.000000000000D+00    0.0D+00    0.0D+00`), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	egm.Zero = -0.41
+
+	if n := egm.Undulation(0, 0); math.Abs(n-12.374+0.41) > 0.001 || egm.Degree != 2 {
+		t.Fatal("Failed", n, egm.Degree)
+	}
+
+	if n := egm.Undulation(90, 0); math.Abs(n+12.374+0.41) > 0.001 {
+		t.Fatal("Failed (2)", n)
+	}
+
+	if n := egm.Undulation(45, 90); math.Abs(n+0.41) > 1e-6 {
+		t.Fatal("Failed (3)", n)
+	}
+
+	grid := egm.Grid(-180, -90, 180, 90, 5)
+
+	if grid.Columns != 72 || grid.Rows != 37 {
+		t.Fatal("Failed (4)", grid.Columns, grid.Rows)
+	}
+
+	if n, m := grid.Undulation(15, 35), egm.Undulation(15, 35); math.Abs(n-m) > 1e-5 {
+		t.Fatal("Failed (5)", n, m)
+	}
+
+	egm96 := wgs84.VerticalDatum{Geoid: egm}
+
+	if h := egm96.FromEllipsoidal(0, 0, 100); math.Abs(h-88.036) > 0.001 {
+		t.Fatal("Failed (6)", h)
+	}
+}
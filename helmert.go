@@ -27,3 +27,82 @@ func calcHelmert(x, y, z, tx, ty, tz, rx, ry, rz, ds float64) (x0, y0, z0 float6
 
 	return
 }
+
+type molodenskyBadekas struct {
+	helmert
+	px, py, pz float64
+}
+
+func (t molodenskyBadekas) Forward(x, y, z float64) (x0, y0, z0 float64) {
+	x0, y0, z0 = calcHelmert(x-t.px, y-t.py, z-t.pz, t.tx, t.ty, t.tz, t.rx, t.ry, t.rz, t.ds)
+
+	return x0 + t.px, y0 + t.py, z0 + t.pz
+}
+
+func (t molodenskyBadekas) Inverse(x0, y0, z0 float64) (x, y, z float64) {
+	x, y, z = calcHelmert(x0-t.px-t.tx, y0-t.py-t.ty, z0-t.pz-t.tz, 0, 0, 0, -t.rx, -t.ry, -t.rz, -t.ds)
+
+	return x + t.px, y + t.py, z + t.pz
+}
+
+// HelmertConvention specifies the sign convention of the rotation parameters
+// of a Helmert-Transformation.
+type HelmertConvention int
+
+const (
+	// PositionVector is the rotation convention of the EPSG method 9606.
+	// It is used by the Helmert function.
+	PositionVector HelmertConvention = iota
+	// CoordinateFrame is the rotation convention of the EPSG method 9607.
+	CoordinateFrame
+)
+
+type rigorousHelmert struct {
+	tx, ty, tz, ds float64
+	r              [3][3]float64
+}
+
+func newRigorousHelmert(tx, ty, tz, rx, ry, rz, ds float64, convention HelmertConvention) rigorousHelmert {
+	sx, cx := math.Sincos(rx * asec)
+	sy, cy := math.Sincos(ry * asec)
+	sz, cz := math.Sincos(rz * asec)
+
+	r := [3][3]float64{
+		{cy * cz, cy * sz, -sy},
+		{sx*sy*cz - cx*sz, sx*sy*sz + cx*cz, sx * cy},
+		{cx*sy*cz + sx*sz, cx*sy*sz - sx*cz, cx * cy},
+	}
+
+	if convention == PositionVector {
+		r[0][1], r[1][0] = r[1][0], r[0][1]
+		r[0][2], r[2][0] = r[2][0], r[0][2]
+		r[1][2], r[2][1] = r[2][1], r[1][2]
+	}
+
+	return rigorousHelmert{
+		tx: tx,
+		ty: ty,
+		tz: tz,
+		ds: ds,
+		r:  r,
+	}
+}
+
+func (t rigorousHelmert) Forward(x, y, z float64) (x0, y0, z0 float64) {
+	m := 1 + t.ds*ppm
+	x0 = m*(t.r[0][0]*x+t.r[0][1]*y+t.r[0][2]*z) + t.tx
+	y0 = m*(t.r[1][0]*x+t.r[1][1]*y+t.r[1][2]*z) + t.ty
+	z0 = m*(t.r[2][0]*x+t.r[2][1]*y+t.r[2][2]*z) + t.tz
+
+	return x0, y0, z0
+}
+
+func (t rigorousHelmert) Inverse(x0, y0, z0 float64) (x, y, z float64) {
+	m := 1 + t.ds*ppm
+	x0, y0, z0 = (x0-t.tx)/m, (y0-t.ty)/m, (z0-t.tz)/m
+	x = t.r[0][0]*x0 + t.r[1][0]*y0 + t.r[2][0]*z0
+	y = t.r[0][1]*x0 + t.r[1][1]*y0 + t.r[2][1]*z0
+	z = t.r[0][2]*x0 + t.r[1][2]*y0 + t.r[2][2]*z0
+
+	return x, y, z
+}
@@ -0,0 +1,73 @@
+//nolint:varnamelen
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestMolodensky(t *testing.T) {
+	t.Parallel()
+
+	clarke := wgs84.Clarke1866{}
+	helmert := wgs84.Helmert(clarke.A(), clarke.Fi(), -8, 160, 176, 0, 0, 0, 0).LonLat()
+
+	for _, datum := range []wgs84.Datum{
+		wgs84.Molodensky(clarke.A(), clarke.Fi(), -8, 160, 176),
+		wgs84.AbridgedMolodensky(clarke.A(), clarke.Fi(), -8, 160, 176),
+	} {
+		lon, lat, h := datum.LonLat().To(wgs84.LonLat())(-100, 40, 0)
+		lon2, lat2, h2 := helmert.To(wgs84.LonLat())(-100, 40, 0)
+
+		if math.Abs(lon-lon2) > 1e-5 || math.Abs(lat-lat2) > 1e-5 || math.Abs(h-h2) > 1 {
+			t.Fatal("Failed", lon-lon2, lat-lat2, h-h2)
+		}
+
+		lon, lat, h = datum.LonLat().From(wgs84.LonLat()).Round(6)(lon, lat, h)
+		if lon != -100 || lat != 40 || math.Abs(h) > 0.01 {
+			t.Fatal("Failed (2)", lon, lat, h)
+		}
+	}
+}
+
+func TestMolodenskyBadekas(t *testing.T) {
+	t.Parallel()
+
+	// EPSG Guidance Note 7-2 example for La Canoa to REGVEN.
+	x, y, z := wgs84.LaCanoa().XYZ().To(wgs84.XYZ()).Round(1)(2550408.96, -5749912.26, 1054891.11)
+	if x != 2550138.5 || y != -5749799.9 || z != 1054530.8 {
+		t.Fatal("Failed", x, y, z)
+	}
+
+	x, y, z = wgs84.LaCanoa().XYZ().From(wgs84.XYZ()).Round(1)(2550138.46, -5749799.87, 1054530.82)
+	if x != 2550409 || y != -5749912.3 || z != 1054891.1 {
+		t.Fatal("Failed (2)", x, y, z)
+	}
+}
+
+func TestRigorousHelmert(t *testing.T) {
+	t.Parallel()
+
+	airy := wgs84.Airy{}
+	helmert := wgs84.Helmert(airy.A(), airy.Fi(), 446.448, -125.157, 542.06, 0.15, 0.247, 0.842, -20.489).XYZ()
+	pv := wgs84.RigorousHelmert(airy.A(), airy.Fi(), 446.448, -125.157, 542.06,
+		0.15, 0.247, 0.842, -20.489, wgs84.PositionVector).XYZ()
+	cf := wgs84.RigorousHelmert(airy.A(), airy.Fi(), 446.448, -125.157, 542.06,
+		-0.15, -0.247, -0.842, -20.489, wgs84.CoordinateFrame).XYZ()
+
+	x, y, z := helmert.To(wgs84.XYZ())(3909833.018, -147097.138, 5020322.478)
+	x1, y1, z1 := pv.To(wgs84.XYZ())(3909833.018, -147097.138, 5020322.478)
+	x2, y2, z2 := cf.To(wgs84.XYZ())(3909833.018, -147097.138, 5020322.478)
+
+	if math.Abs(x-x1) > 0.001 || math.Abs(y-y1) > 0.001 || math.Abs(z-z1) > 0.001 ||
+		math.Abs(x1-x2) > 0.001 || math.Abs(y1-y2) > 0.001 || math.Abs(z1-z2) > 0.001 {
+		t.Fatal("Failed", x-x1, y-y1, z-z1)
+	}
+
+	x, y, z = pv.From(wgs84.XYZ()).Round(6)(x1, y1, z1)
+	if x != 3909833.018 || y != -147097.138 || z != 5020322.478 {
+		t.Fatal("Failed (2)", x, y, z)
+	}
+}
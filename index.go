@@ -0,0 +1,248 @@
+//nolint:varnamelen,gomnd
+package wgs84
+
+import (
+	"math"
+	"sort"
+)
+
+// indexCell is the size of the cells of the coverIndex in degrees.
+const indexCell = 2
+
+// coverIndex is a grid over the bounds of the areas of use of the
+// CoordinateReferenceSystems of a Repository. Systems without bounds or with
+// large bounds are checked for every location.
+type coverIndex struct {
+	cells [360 / indexCell][180 / indexCell][]coverEntry
+	large []coverEntry
+}
+
+type coverEntry struct {
+	code int
+	crs  CoordinateReferenceSystem
+}
+
+func newCoverIndex(codes map[int]CoordinateReferenceSystem) *coverIndex {
+	index := &coverIndex{}
+	columns, rows := len(index.cells), len(index.cells[0])
+
+	for code, crs := range codes {
+		entry := coverEntry{code: code, crs: crs}
+
+		b, ok := boundsOf(crs)
+		if !ok || b.width()*(b.North-b.South) > 180*90 {
+			index.large = append(index.large, entry)
+
+			continue
+		}
+
+		if b.South > b.North {
+			continue
+		}
+
+		south, north := indexRow(b.South), indexRow(b.North)
+		west, east := indexColumn(b.West), indexColumn(b.East)
+
+		for c := west; ; c = (c + 1) % columns {
+			for r := south; r <= north && r < rows; r++ {
+				index.cells[c][r] = append(index.cells[c][r], entry)
+			}
+
+			if c == east {
+				break
+			}
+		}
+	}
+
+	for c := range index.cells {
+		for r := range index.cells[c] {
+			sortEntries(index.cells[c][r])
+		}
+	}
+
+	sortEntries(index.large)
+
+	return index
+}
+
+func indexColumn(lon float64) int {
+	c := int(math.Floor((wrapLon(lon) + 180) / indexCell))
+	if c >= 360/indexCell {
+		c = 360/indexCell - 1
+	}
+
+	return c
+}
+
+func indexRow(lat float64) int {
+	r := int(math.Floor((math.Max(-90, math.Min(90, lat)) + 90) / indexCell))
+	if r >= 180/indexCell {
+		r = 180/indexCell - 1
+	}
+
+	return r
+}
+
+func sortEntries(entries []coverEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].code < entries[j].code
+	})
+}
+
+// cover returns the sorted codes covering a location.
+func (index *coverIndex) cover(lon, lat float64) []int {
+	var cc []int
+
+	cell := index.cells[indexColumn(lon)][indexRow(lat)]
+	large := index.large
+
+	for len(cell) > 0 || len(large) > 0 {
+		var e coverEntry
+
+		if len(large) == 0 || (len(cell) > 0 && cell[0].code < large[0].code) {
+			e, cell = cell[0], cell[1:]
+		} else {
+			e, large = large[0], large[1:]
+		}
+
+		if e.crs.Contains(lon, lat) {
+			cc = append(cc, e.code)
+		}
+	}
+
+	return cc
+}
+
+// coverIndex returns the coverIndex of the Repository and builds it if the
+// codes changed.
+func (r *Repository) coverIndex() *coverIndex {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.index == nil {
+		r.index = newCoverIndex(r.codes)
+	}
+
+	return r.index
+}
+
+// CodesCoverPoints returns the sorted Codes covering each of many geographic
+// WGS84 locations. The locations are classified in parallel.
+func (r *Repository) CodesCoverPoints(lonlat [][2]float64) [][]int {
+	index := r.coverIndex()
+	codes := make([][]int, len(lonlat))
+
+	parallel(len(lonlat), 256, func(start, end int) {
+		for i := start; i < end; i++ {
+			codes[i] = index.cover(lonlat[i][0], lonlat[i][1])
+		}
+	})
+
+	return codes
+}
+
+// boundsOf returns the BoundingBox of an Area or a CoordinateReferenceSystem
+// if it is known.
+func boundsOf(a Area) (BoundingBox, bool) {
+	switch v := a.(type) {
+	case interface{ Bounds() BoundingBox }:
+		return v.Bounds(), true
+	case Datum:
+		if v.Area == nil {
+			return BoundingBox{}, false
+		}
+
+		return boundsOf(v.Area)
+	case GeographicReferenceSystem:
+		return boundsOf(v.Datum)
+	case GeocentricReferenceSystem:
+		return boundsOf(v.Datum)
+	case ProjectedReferenceSystem:
+		return smallerBounds(v.Datum, v.Area)
+	case RotatedReferenceSystem:
+		return smallerBounds(v.Datum, v.Area)
+	case TopocentricReferenceSystem:
+		return smallerBounds(v.Datum, v.Area)
+	case PlanetocentricReferenceSystem:
+		return smallerBounds(v.Datum, v.Area)
+	case PlanetographicReferenceSystem:
+		return smallerBounds(v.Datum, v.Area)
+	case LocalReferenceSystem:
+		return smallerBounds(v.Base, v.Area)
+	case CompoundReferenceSystem:
+		if v.Horizontal == nil {
+			return BoundingBox{}, false
+		}
+
+		return boundsOf(v.Horizontal)
+	}
+
+	return BoundingBox{}, false
+}
+
+// smallerBounds returns the smaller of the bounds of two Areas which contains
+// their intersection.
+func smallerBounds(a, b Area) (BoundingBox, bool) {
+	ba, okA := boundsOf(a)
+	if b == nil {
+		return ba, okA
+	}
+
+	bb, okB := boundsOf(b)
+
+	switch {
+	case !okA:
+		return bb, okB
+	case !okB:
+		return ba, okA
+	case bb.width()*(bb.North-bb.South) < ba.width()*(ba.North-ba.South):
+		return bb, true
+	}
+
+	return ba, true
+}
+
+// unionBounds returns the smallest BoundingBox containing all boxes by
+// leaving out the largest longitudinal gap between them.
+func unionBounds(boxes []BoundingBox) BoundingBox {
+	union := BoundingBox{West: 0, South: 90, East: 0, North: -90}
+	if len(boxes) == 0 {
+		return union
+	}
+
+	type interval struct{ west, east float64 }
+
+	intervals := make([]interval, 0, len(boxes))
+
+	for _, b := range boxes {
+		union.South, union.North = math.Min(union.South, b.South), math.Max(union.North, b.North)
+		intervals = append(intervals, interval{b.West, b.West + b.width()})
+	}
+
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].west < intervals[j].west
+	})
+
+	union.West, union.East = intervals[0].west, intervals[0].east
+	gap, west, east := 0.0, intervals[0].west, intervals[0].east
+
+	for _, i := range intervals[1:] {
+		if i.west-east > gap {
+			gap, union.West, union.East = i.west-east, i.west, east
+		}
+
+		east = math.Max(east, i.east)
+	}
+
+	if west+360-east > gap {
+		gap, union.West, union.East = west+360-east, west, east
+	}
+
+	if gap <= 0 {
+		union.West, union.East = -180, 180
+	} else {
+		union.West, union.East = wrapLon(union.West), wrapLon(union.East)
+	}
+
+	return union
+}
@@ -0,0 +1,373 @@
+//nolint:varnamelen,nonamedreturns,gomnd,asciicheck
+package wgs84
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrEOP is returned for unreadable Earth orientation parameter files.
+var ErrEOP = errors.New("invalid earth orientation parameters")
+
+// InertialFrame specifies the Earth-centered inertial frame of an
+// InertialReferenceSystem.
+type InertialFrame int
+
+const (
+	// TEME is the true equator, mean equinox frame of the SGP4 propagator.
+	TEME InertialFrame = iota
+
+	// J2000 is the mean equator and equinox frame of J2000.0 (FK5).
+	J2000
+
+	// GCRS is the geocentric celestial reference system.
+	GCRS
+)
+
+// Inertial is an Earth-centered inertial Coordinate Reference System at the
+// time t. The EOP are optional.
+func Inertial(frame InertialFrame, t time.Time, eop EOP) InertialReferenceSystem {
+	return InertialReferenceSystem{
+		Frame: frame,
+		Time:  t,
+		EOP:   eop,
+	}
+}
+
+// InertialReferenceSystem represents Earth-centered inertial coordinates in
+// meters at a certain Time. The coordinates are rotated into the Earth-fixed
+// frame by the IAU 1976 precession, the truncated IAU 1980 nutation, the
+// Greenwich sidereal time and the polar motion of the EOP.
+//
+// The ITRF is considered to be equal to WGS84. Without EOP the difference
+// between UT1 and UTC and the polar motion are ignored.
+type InertialReferenceSystem struct {
+	Frame InertialFrame
+	Time  time.Time
+	EOP   EOP
+}
+
+// Contains method is the implementation of the Area interface.
+func (crs InertialReferenceSystem) Contains(lon, lat float64) bool {
+	return WGS84().Contains(lon, lat)
+}
+
+// ToWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs InertialReferenceSystem) ToWGS84(x, y, z float64) (x0, y0, z0 float64) {
+	m := crs.matrix()
+
+	return m[0][0]*x + m[0][1]*y + m[0][2]*z,
+		m[1][0]*x + m[1][1]*y + m[1][2]*z,
+		m[2][0]*x + m[2][1]*y + m[2][2]*z
+}
+
+// FromWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs InertialReferenceSystem) FromWGS84(x0, y0, z0 float64) (x, y, z float64) {
+	m := crs.matrix()
+
+	return m[0][0]*x0 + m[1][0]*y0 + m[2][0]*z0,
+		m[0][1]*x0 + m[1][1]*y0 + m[2][1]*z0,
+		m[0][2]*x0 + m[1][2]*y0 + m[2][2]*z0
+}
+
+// To provides the transformation to another CoordinateReferenceSystem.
+func (crs InertialReferenceSystem) To(to CoordinateReferenceSystem) Func {
+	return Transform(crs, to)
+}
+
+// SafeTo provides the transformation to another CoordinateReferenceSystem
+// with errors.
+func (crs InertialReferenceSystem) SafeTo(to CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(crs, to)
+}
+
+// From provides the transformation from another CoordinateReferenceSystem.
+func (crs InertialReferenceSystem) From(from CoordinateReferenceSystem) Func {
+	return Transform(from, crs)
+}
+
+// SafeFrom provides the transformation from another CoordinateReferenceSystem
+// with errors.
+func (crs InertialReferenceSystem) SafeFrom(from CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(from, crs)
+}
+
+// matrix returns the rotation from the inertial frame to the Earth-fixed frame.
+func (crs InertialReferenceSystem) matrix() [3][3]float64 {
+	eo := crs.EOP.At(crs.Time)
+	utc := julianDate(crs.Time)
+	ut1 := utc + eo.UT1UTC/86400
+	tt := utc + (leapSeconds(crs.Time)+32.184)/86400
+	t := (tt - 2451545) / 36525
+
+	// Greenwich mean sidereal time (IAU 1982)
+	tu := (ut1 - 2451545) / 36525
+	gmst := math.Mod(67310.54841+(876600*3600+8640184.812866)*tu+0.093104*tu*tu-6.2e-6*tu*tu*tu, 86400) / 240
+
+	// polar motion
+	w := mul(rotY(-arcsec(eo.X)), rotX(-arcsec(eo.Y)))
+
+	if crs.Frame == TEME {
+		return mul(w, rotZ(radian(gmst)))
+	}
+
+	// precession (IAU 1976)
+	ζ := arcsec(2306.2181*t + 0.30188*t*t + 0.017998*t*t*t)
+	θ := arcsec(2004.3109*t - 0.42665*t*t - 0.041833*t*t*t)
+	z := arcsec(2306.2181*t + 1.09468*t*t + 0.018203*t*t*t)
+	p := mul(rotZ(-z), mul(rotY(θ), rotZ(-ζ)))
+
+	// nutation (IAU 1980)
+	ε0 := arcsec(84381.448 - 46.8150*t - 0.00059*t*t + 0.001813*t*t*t)
+	Δψ, Δε, Ω := nutation(t)
+	n := mul(rotX(-(ε0 + Δε)), mul(rotZ(-Δψ), rotX(ε0)))
+
+	// equation of the equinoxes
+	gast := radian(gmst) + Δψ*math.Cos(ε0) + arcsec(0.00264*math.Sin(Ω)+0.000063*math.Sin(2*Ω))
+
+	m := mul(w, mul(rotZ(gast), mul(n, p)))
+
+	if crs.Frame == GCRS {
+		// frame bias
+		b := mul(rotX(-arcsec(-0.0068192)), mul(rotY(arcsec(-0.0166170)), rotZ(arcsec(-0.01460))))
+
+		return mul(m, b)
+	}
+
+	return m
+}
+
+// nutationTerms are the largest terms of the IAU 1980 nutation series with
+// the multipliers of D, M, M', F and Ω and the coefficients in 0.0001".
+//
+//nolint:gochecknoglobals
+var nutationTerms = [][9]float64{
+	{0, 0, 0, 0, 1, -171996, -174.2, 92025, 8.9},
+	{-2, 0, 0, 2, 2, -13187, -1.6, 5736, -3.1},
+	{0, 0, 0, 2, 2, -2274, -0.2, 977, -0.5},
+	{0, 0, 0, 0, 2, 2062, 0.2, -895, 0.5},
+	{0, 1, 0, 0, 0, 1426, -3.4, 54, -0.1},
+	{0, 0, 1, 0, 0, 712, 0.1, -7, 0},
+	{-2, 1, 0, 2, 2, -517, 1.2, 224, -0.6},
+	{0, 0, 0, 2, 1, -386, -0.4, 200, 0},
+	{0, 0, 1, 2, 2, -301, 0, 129, -0.1},
+	{-2, -1, 0, 2, 2, 217, -0.5, -95, 0.3},
+	{-2, 0, 1, 0, 0, -158, 0, 0, 0},
+	{-2, 0, 0, 2, 1, 129, 0.1, -70, 0},
+	{0, 0, -1, 2, 2, 123, 0, -53, 0},
+	{2, 0, 0, 0, 0, 63, 0, 0, 0},
+	{0, 0, 1, 0, 1, 63, 0.1, -33, 0},
+	{2, 0, -1, 2, 2, -59, 0, 26, 0},
+	{0, 0, -1, 0, 1, -58, -0.1, 32, 0},
+	{0, 0, 1, 2, 1, -51, 0, 27, 0},
+	{-2, 0, 2, 0, 0, 48, 0, 0, 0},
+	{0, 0, -2, 2, 1, 46, 0, -24, 0},
+	{2, 0, 0, 2, 2, -38, 0, 16, 0},
+	{0, 0, 2, 2, 2, -31, 0, 13, 0},
+	{0, 0, 2, 0, 0, 29, 0, 0, 0},
+	{-2, 0, 1, 2, 2, 29, 0, -12, 0},
+	{0, 0, 0, 2, 0, 26, 0, 0, 0},
+	{-2, 0, 0, 2, 0, -22, 0, 0, 0},
+	{0, 0, -1, 2, 1, 21, 0, -10, 0},
+	{0, 2, 0, 0, 0, 17, -0.1, 0, 0},
+	{2, 0, -1, 0, 1, 16, 0, -8, 0},
+	{-2, 2, 0, 2, 2, -16, 0.1, 7, 0},
+	{0, 1, 0, 0, 1, -15, 0, 9, 0},
+	{-2, 0, 1, 0, 1, -13, 0, 7, 0},
+	{0, -1, 0, 0, 1, -12, 0, 6, 0},
+}
+
+// nutation returns the nutation in longitude and obliquity and the longitude
+// of the ascending node of the moon in radians.
+func nutation(t float64) (Δψ, Δε, Ω float64) {
+	args := [5]float64{
+		radian(297.85036 + 445267.111480*t - 0.0019142*t*t + t*t*t/189474),
+		radian(357.52772 + 35999.050340*t - 0.0001603*t*t - t*t*t/300000),
+		radian(134.96298 + 477198.867398*t + 0.0086972*t*t + t*t*t/56250),
+		radian(93.27191 + 483202.017538*t - 0.0036825*t*t + t*t*t/327270),
+		radian(125.04452 - 1934.136261*t + 0.0020708*t*t + t*t*t/450000),
+	}
+
+	for _, term := range nutationTerms {
+		a := 0.0
+		for i := range args {
+			a += term[i] * args[i]
+		}
+
+		Δψ += (term[5] + term[6]*t) * math.Sin(a)
+		Δε += (term[7] + term[8]*t) * math.Cos(a)
+	}
+
+	return arcsec(Δψ / 10000), arcsec(Δε / 10000), args[4]
+}
+
+// leapSecondDates are the dates of the leap seconds since 1972.
+//
+//nolint:gochecknoglobals
+var leapSecondDates = []string{
+	"1972-07-01", "1973-01-01", "1974-01-01", "1975-01-01", "1976-01-01",
+	"1977-01-01", "1978-01-01", "1979-01-01", "1980-01-01", "1981-07-01",
+	"1982-07-01", "1983-07-01", "1985-07-01", "1988-01-01", "1990-01-01",
+	"1991-01-01", "1992-07-01", "1993-07-01", "1994-07-01", "1996-01-01",
+	"1997-07-01", "1999-01-01", "2006-01-01", "2009-01-01", "2012-07-01",
+	"2015-07-01", "2017-01-01",
+}
+
+// leapSeconds returns the difference between TAI and UTC.
+func leapSeconds(t time.Time) float64 {
+	date := t.UTC().Format("2006-01-02")
+
+	return 10 + float64(sort.SearchStrings(leapSecondDates, date+"~"))
+}
+
+func julianDate(t time.Time) float64 {
+	return float64(t.UnixNano())/86400e9 + 2440587.5
+}
+
+func arcsec(a float64) float64 {
+	return radian(a / 3600)
+}
+
+func rotX(a float64) [3][3]float64 {
+	s, c := math.Sincos(a)
+
+	return [3][3]float64{{1, 0, 0}, {0, c, s}, {0, -s, c}}
+}
+
+func rotY(a float64) [3][3]float64 {
+	s, c := math.Sincos(a)
+
+	return [3][3]float64{{c, 0, -s}, {0, 1, 0}, {s, 0, c}}
+}
+
+func rotZ(a float64) [3][3]float64 {
+	s, c := math.Sincos(a)
+
+	return [3][3]float64{{c, s, 0}, {-s, c, 0}, {0, 0, 1}}
+}
+
+func mul(a, b [3][3]float64) (m [3][3]float64) {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				m[i][j] += a[i][k] * b[k][j]
+			}
+		}
+	}
+
+	return m
+}
+
+// EarthOrientation are the Earth orientation parameters of a day. X and Y
+// are the coordinates of the pole in arc seconds and UT1UTC is the
+// difference between UT1 and UTC in seconds.
+type EarthOrientation struct {
+	MJD, X, Y, UT1UTC float64
+}
+
+// EOP is a daily series of EarthOrientation ordered by the modified Julian
+// date.
+type EOP []EarthOrientation
+
+// At returns the linear interpolated EarthOrientation at a time. The first
+// or last values are used outside of the series.
+func (eop EOP) At(t time.Time) EarthOrientation {
+	if len(eop) == 0 {
+		return EarthOrientation{}
+	}
+
+	mjd := julianDate(t) - 2400000.5
+
+	i := sort.Search(len(eop), func(i int) bool { return eop[i].MJD > mjd })
+	if i == 0 {
+		return eop[0]
+	}
+
+	if i == len(eop) {
+		return eop[i-1]
+	}
+
+	a, b := eop[i-1], eop[i]
+	f := (mjd - a.MJD) / (b.MJD - a.MJD)
+
+	return EarthOrientation{
+		MJD:    mjd,
+		X:      a.X + f*(b.X-a.X),
+		Y:      a.Y + f*(b.Y-a.Y),
+		UT1UTC: a.UT1UTC + f*(b.UT1UTC-a.UT1UTC),
+	}
+}
+
+// LoadEOP reads an IERS file like finals2000A.all from a local path.
+func LoadEOP(path string) (EOP, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadEOP(f)
+}
+
+// ReadEOP reads the polar motion and UT1-UTC of the IERS Bulletin A in the
+// fixed-width format of finals.all or finals2000A.all. Days without values
+// are skipped.
+func ReadEOP(r io.Reader) (EOP, error) {
+	var eop EOP
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		text := scanner.Text()
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		if len(text) < 15 {
+			return nil, fmt.Errorf("%w: line %d", ErrEOP, line)
+		}
+
+		mjd, err := strconv.ParseFloat(strings.TrimSpace(text[7:15]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d", ErrEOP, line)
+		}
+
+		if len(text) < 68 {
+			continue
+		}
+
+		x, err1 := strconv.ParseFloat(strings.TrimSpace(text[18:27]), 64)
+		y, err2 := strconv.ParseFloat(strings.TrimSpace(text[37:46]), 64)
+		dut1, err3 := strconv.ParseFloat(strings.TrimSpace(text[58:68]), 64)
+
+		// predictions might be missing
+		if err1 != nil || err2 != nil || err3 != nil {
+			continue
+		}
+
+		eop = append(eop, EarthOrientation{MJD: mjd, X: x, Y: y, UT1UTC: dut1})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrEOP, err)
+	}
+
+	if len(eop) == 0 {
+		return nil, fmt.Errorf("%w: no values", ErrEOP)
+	}
+
+	sort.Slice(eop, func(i, j int) bool { return eop[i].MJD < eop[j].MJD })
+
+	return eop, nil
+}
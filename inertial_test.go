@@ -0,0 +1,52 @@
+//nolint:varnamelen
+package wgs84_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestInertial(t *testing.T) {
+	t.Parallel()
+
+	// Vallado, Fundamentals of Astrodynamics and Applications, Example 3-15.
+	eop, err := wgs84.ReadEOP(strings.NewReader(
+		"04 4 6 53101.00 I -0.140682 0.000000  0.333309 0.000000  I-0.4399619 0.0000000\n" +
+			"04 4 7 53102.00 I -0.140682 0.000000  0.333309 0.000000  I-0.4399619 0.0000000\n" +
+			"04 4 8 53103.00                                                                \n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(eop) != 2 {
+		t.Fatal("Failed", eop)
+	}
+
+	epoch := time.Date(2004, 4, 6, 7, 51, 28, 386009000, time.UTC)
+
+	for i, tc := range []struct {
+		frame   wgs84.InertialFrame
+		x, y, z float64
+		tol     float64
+	}{
+		{wgs84.TEME, 5094180.16210, 6127644.65950, 6380344.53270, 0.001},
+		{wgs84.J2000, 5102509.6, 6123011.52, 6378136.30, 0.5},
+		{wgs84.GCRS, 5102508.958, 6123011.401, 6378136.928, 2},
+	} {
+		crs := wgs84.Inertial(tc.frame, epoch, eop)
+
+		x, y, z := crs.To(wgs84.XYZ())(tc.x, tc.y, tc.z)
+		if d := math.Sqrt(math.Pow(x+1033479.3830, 2) + math.Pow(y-7901295.2754, 2) + math.Pow(z-6380356.5958, 2)); d > tc.tol {
+			t.Fatal("Failed (2)", i, d)
+		}
+
+		x, y, z = crs.From(wgs84.XYZ())(x, y, z)
+		if d := math.Sqrt(math.Pow(x-tc.x, 2) + math.Pow(y-tc.y, 2) + math.Pow(z-tc.z, 2)); d > 1e-6 {
+			t.Fatal("Failed (3)", i, d)
+		}
+	}
+}
@@ -18,6 +18,16 @@ type Transformation interface {
 	Inverse(x0, y0, z0 float64) (x, y, z float64)
 }
 
+// PlanarTransformation interface represents the transformation of local
+// engineering coordinates To and From a projected Coordinate Reference System.
+//
+// The Forward method is used to transform local coordinates to the projected
+// Coordinate Reference System.
+type PlanarTransformation interface {
+	Forward(x, y float64) (east, north float64)
+	Inverse(east, north float64) (x, y float64)
+}
+
 // Projection interface is used by the several Projected Coordinate
 // Reference System's in this package.
 //
@@ -45,3 +55,20 @@ type CoordinateReferenceSystem interface {
 type Area interface {
 	Contains(lon, lat float64) bool
 }
+
+// Geoid interface returns the height of a geoid above the ellipsoid at a
+// geographic location.
+//
+// It is implemented by the GeoidGrid.
+type Geoid interface {
+	Undulation(lon, lat float64) float64
+}
+
+// VerticalReferenceSystem interface represents heights relative to a
+// vertical datum.
+//
+// The ToEllipsoidal method is used to convert heights to ellipsoidal heights.
+type VerticalReferenceSystem interface {
+	ToEllipsoidal(lon, lat, height float64) (h float64)
+	FromEllipsoidal(lon, lat, h float64) (height float64)
+}
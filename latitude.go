@@ -0,0 +1,250 @@
+//nolint:varnamelen,nonamedreturns,gomnd,asciicheck
+package wgs84
+
+import "math"
+
+// Ellipsoid provides auxiliary latitudes, radii of curvature and meridian
+// arcs of a Spheroid. All angles are in degrees.
+//
+// By default it behaves like the WGS84 Spheroid.
+type Ellipsoid struct {
+	Spheroid Spheroid
+}
+
+func (el Ellipsoid) sph() spheroid {
+	if el.Spheroid == nil {
+		return spheroid{a: A, fi: Fi}
+	}
+
+	return spheroid{a: el.Spheroid.A(), fi: el.Spheroid.Fi()}
+}
+
+// Geodetic converts geocentric coordinates to geodetic longitudes, latitudes
+// and heights. It is accurate at all heights, on the polar axis and at the
+// center of the Spheroid.
+func (el Ellipsoid) Geodetic(x, y, z float64) (lon, lat, h float64) {
+	sph := el.sph()
+
+	return xyzToLonLat(x, y, z, sph.A(), sph.Fi())
+}
+
+// Geocentric converts geodetic longitudes, latitudes and heights to
+// geocentric coordinates.
+func (el Ellipsoid) Geocentric(lon, lat, h float64) (x, y, z float64) {
+	sph := el.sph()
+
+	return lonLatToXYZ(lon, lat, h, sph.A(), sph.Fi())
+}
+
+// GeocentricLatitude converts a geodetic latitude to the geocentric latitude.
+func (el Ellipsoid) GeocentricLatitude(lat float64) float64 {
+	return el.sph().fromTan(lat, 1-el.sph().e2())
+}
+
+// InverseGeocentricLatitude converts a geocentric latitude to the geodetic
+// latitude.
+func (el Ellipsoid) InverseGeocentricLatitude(lat float64) float64 {
+	return el.sph().fromTan(lat, 1/(1-el.sph().e2()))
+}
+
+// ParametricLatitude converts a geodetic latitude to the parametric or
+// reduced latitude.
+func (el Ellipsoid) ParametricLatitude(lat float64) float64 {
+	return el.sph().fromTan(lat, 1-el.sph().f())
+}
+
+// InverseParametricLatitude converts a parametric latitude to the geodetic
+// latitude.
+func (el Ellipsoid) InverseParametricLatitude(lat float64) float64 {
+	return el.sph().fromTan(lat, 1/(1-el.sph().f()))
+}
+
+// IsometricLatitude converts a geodetic latitude to the dimensionless
+// isometric latitude.
+func (el Ellipsoid) IsometricLatitude(lat float64) float64 {
+	return el.sph().isometric(radian(lat))
+}
+
+// InverseIsometricLatitude converts an isometric latitude to the geodetic
+// latitude.
+func (el Ellipsoid) InverseIsometricLatitude(ψ float64) float64 {
+	return degree(el.sph().invIsometric(ψ))
+}
+
+// ConformalLatitude converts a geodetic latitude to the conformal latitude.
+func (el Ellipsoid) ConformalLatitude(lat float64) float64 {
+	return degree(math.Atan(math.Sinh(el.sph().isometric(radian(lat)))))
+}
+
+// InverseConformalLatitude converts a conformal latitude to the geodetic
+// latitude.
+func (el Ellipsoid) InverseConformalLatitude(lat float64) float64 {
+	return degree(el.sph().invIsometric(math.Asinh(math.Tan(radian(lat)))))
+}
+
+// AuthalicLatitude converts a geodetic latitude to the authalic latitude.
+func (el Ellipsoid) AuthalicLatitude(lat float64) float64 {
+	sph := el.sph()
+	if math.Abs(lat) == 90 {
+		return lat
+	}
+
+	return degree(math.Asin(clamp(sph.q(radian(lat)) / sph.qp())))
+}
+
+// InverseAuthalicLatitude converts an authalic latitude to the geodetic
+// latitude.
+func (el Ellipsoid) InverseAuthalicLatitude(lat float64) float64 {
+	sph := el.sph()
+
+	return degree(sph.fromQ(sph.qp() * math.Sin(radian(lat))))
+}
+
+// RectifyingLatitude converts a geodetic latitude to the rectifying latitude.
+func (el Ellipsoid) RectifyingLatitude(lat float64) float64 {
+	return degree(el.sph().rectifying(radian(lat)))
+}
+
+// InverseRectifyingLatitude converts a rectifying latitude to the geodetic
+// latitude.
+func (el Ellipsoid) InverseRectifyingLatitude(lat float64) float64 {
+	return degree(el.sph().invRectifying(radian(lat)))
+}
+
+// MeridionalRadius returns the radius of curvature in the meridian.
+func (el Ellipsoid) MeridionalRadius(lat float64) float64 {
+	sph := el.sph()
+
+	return sph.A() * (1 - sph.e2()) / math.Pow(1-sph.e2()*sin2(radian(lat)), 1.5)
+}
+
+// PrimeVerticalRadius returns the radius of curvature in the prime vertical.
+func (el Ellipsoid) PrimeVerticalRadius(lat float64) float64 {
+	return _N(radian(lat), el.sph())
+}
+
+// GaussianRadius returns the Gaussian mean radius of curvature.
+func (el Ellipsoid) GaussianRadius(lat float64) float64 {
+	return math.Sqrt(el.MeridionalRadius(lat) * el.PrimeVerticalRadius(lat))
+}
+
+// MeridianArc returns the distance along the meridian from the equator.
+func (el Ellipsoid) MeridianArc(lat float64) float64 {
+	sph := el.sph()
+
+	return sph.rectifyingRadius() * sph.rectifying(radian(lat))
+}
+
+// AuthalicRadius returns the radius of the sphere with the same surface area.
+func (el Ellipsoid) AuthalicRadius() float64 {
+	sph := el.sph()
+
+	return sph.A() * math.Sqrt(sph.qp()/2)
+}
+
+// RectifyingRadius returns the radius of the sphere with the same meridian
+// length.
+func (el Ellipsoid) RectifyingRadius() float64 {
+	return el.sph().rectifyingRadius()
+}
+
+// fromTan returns the latitude in degrees with a scaled tangent.
+func (s spheroid) fromTan(lat, scale float64) float64 {
+	if math.Abs(lat) == 90 {
+		return lat
+	}
+
+	return degree(math.Atan(scale * math.Tan(radian(lat))))
+}
+
+func (s spheroid) isometric(φ float64) float64 {
+	return math.Asinh(math.Tan(φ)) - s.e()*math.Atanh(s.e()*math.Sin(φ))
+}
+
+// invIsometric solves the isometric latitude by a fixed-point iteration.
+func (s spheroid) invIsometric(ψ float64) float64 {
+	q := ψ
+
+	for i := 0; i < 30; i++ {
+		next := ψ + s.e()*math.Atanh(s.e()*math.Tanh(q))
+		if math.Abs(next-q) < 1e-15 {
+			q = next
+
+			break
+		}
+
+		q = next
+	}
+
+	return math.Atan(math.Sinh(q))
+}
+
+// q is the authalic function of Snyder.
+func (s spheroid) q(φ float64) float64 {
+	e := s.e()
+	sin := math.Sin(φ)
+
+	if e == 0 {
+		return 2 * sin
+	}
+
+	return (1 - s.e2()) * (sin/(1-s.e2()*sin*sin) - 1/(2*e)*math.Log((1-e*sin)/(1+e*sin)))
+}
+
+func (s spheroid) qp() float64 {
+	return s.q(math.Pi / 2)
+}
+
+// fromQ solves the latitude of the authalic function by Newton iterations.
+func (s spheroid) fromQ(q float64) float64 {
+	qp := s.qp()
+	if math.Abs(q) >= qp {
+		return math.Copysign(math.Pi/2, q)
+	}
+
+	φ := math.Asin(q / qp)
+	if s.e() == 0 {
+		return φ
+	}
+
+	for i := 0; i < 20; i++ {
+		sin, cos := math.Sincos(φ)
+		w := 1 - s.e2()*sin*sin
+		δ := w * w / (2 * cos) * (q/(1-s.e2()) - sin/w + 1/(2*s.e())*math.Log((1-s.e()*sin)/(1+s.e()*sin)))
+		φ += δ
+
+		if math.Abs(δ) < 1e-15 {
+			break
+		}
+	}
+
+	return φ
+}
+
+func (s spheroid) rectifyingRadius() float64 {
+	n := s.ei()
+
+	return s.A() / (1 + n) * (1 + n*n/4 + n*n*n*n/64)
+}
+
+func (s spheroid) rectifying(φ float64) float64 {
+	n := s.ei()
+
+	return φ + (-3*n/2+9*n*n*n/16)*math.Sin(2*φ) +
+		(15*n*n/16-15*n*n*n*n/32)*math.Sin(4*φ) -
+		35*n*n*n/48*math.Sin(6*φ) +
+		315*n*n*n*n/512*math.Sin(8*φ)
+}
+
+func (s spheroid) invRectifying(μ float64) float64 {
+	n := s.ei()
+
+	return μ + (3*n/2-27*n*n*n/32)*math.Sin(2*μ) +
+		(21*n*n/16-55*n*n*n*n/32)*math.Sin(4*μ) +
+		151*n*n*n/96*math.Sin(6*μ) +
+		1097*n*n*n*n/512*math.Sin(8*μ)
+}
+
+func clamp(v float64) float64 {
+	return math.Max(-1, math.Min(1, v))
+}
@@ -0,0 +1,94 @@
+//nolint:varnamelen
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestEllipsoid(t *testing.T) {
+	t.Parallel()
+
+	el := wgs84.Ellipsoid{}
+
+	for i, tc := range []struct {
+		got, want, tol float64
+	}{
+		{el.RectifyingRadius(), 6367449.1458, 1e-4},
+		{el.AuthalicRadius(), 6371007.1809, 1e-4},
+		{el.MeridianArc(90), 10001965.7293, 1e-4},
+		{el.MeridianArc(45), 4984944.3779, 1e-3},
+		{el.MeridionalRadius(0), 6335439.3273, 1e-4},
+		{el.PrimeVerticalRadius(0), 6378137, 1e-9},
+		{el.GaussianRadius(90), 6399593.6258, 1e-4},
+		{el.GeocentricLatitude(45), 44.80757678, 1e-8},
+	} {
+		if math.Abs(tc.got-tc.want) > tc.tol {
+			t.Fatal("Failed", i, tc.got, tc.want)
+		}
+	}
+
+	for _, lat := range []float64{-90, -60, -12.5, 0, 30, 45, 89.9, 90} {
+		for i, pair := range [][2]func(float64) float64{
+			{el.GeocentricLatitude, el.InverseGeocentricLatitude},
+			{el.ParametricLatitude, el.InverseParametricLatitude},
+			{el.IsometricLatitude, el.InverseIsometricLatitude},
+			{el.ConformalLatitude, el.InverseConformalLatitude},
+			{el.AuthalicLatitude, el.InverseAuthalicLatitude},
+			{el.RectifyingLatitude, el.InverseRectifyingLatitude},
+		} {
+			if i == 2 && math.Abs(lat) == 90 {
+				continue
+			}
+
+			if got := pair[1](pair[0](lat)); math.Abs(got-lat) > 1e-9 {
+				t.Fatal("Failed (2)", i, lat, got)
+			}
+		}
+	}
+
+	sphere := wgs84.Ellipsoid{Spheroid: wgs84.Moon}
+
+	if a, c, r := sphere.AuthalicLatitude(30), sphere.ConformalLatitude(30), sphere.RectifyingLatitude(30); math.Abs(a-30) > 1e-12 || math.Abs(c-30) > 1e-12 || math.Abs(r-30) > 1e-12 {
+		t.Fatal("Failed (3)", a, c, r)
+	}
+}
+
+func TestGeodetic(t *testing.T) {
+	t.Parallel()
+
+	el := wgs84.Ellipsoid{}
+
+	for i, tc := range []struct {
+		x, y, z, lon, lat, h float64
+	}{
+		{6378137, 0, 0, 0, 0, 0},
+		{0, 0, 6356752.314245, 0, 90, 0},
+		{0, 0, -6356852.314245, 0, -90, 100},
+		{0, 0, 0, 0, 90, -6356752.314245},
+	} {
+		lon, lat, h := el.Geodetic(tc.x, tc.y, tc.z)
+		if math.Abs(lon-tc.lon) > 1e-12 || math.Abs(lat-tc.lat) > 1e-12 || math.Abs(h-tc.h) > 1e-6 {
+			t.Fatal("Failed", i, lon, lat, h)
+		}
+	}
+
+	// on the equatorial plane inside of the evolute
+	lon, lat, h := el.Geodetic(1000, 0, 0)
+	if x, y, z := el.Geocentric(lon, lat, h); math.Abs(x-1000) > 1e-6 || math.Abs(y) > 1e-6 || math.Abs(z) > 1e-6 || lat <= 0 {
+		t.Fatal("Failed (2)", lon, lat, h, x, y, z)
+	}
+
+	for _, h := range []float64{-6000000, -1000, 0, 8848, 400000, 20200000, 384400000} {
+		for _, lat := range []float64{-90, -89.999999, -45, 0, 0.000001, 30, 60, 89.9999, 90} {
+			x, y, z := el.Geocentric(135, lat, h)
+
+			lon, lat2, h2 := el.Geodetic(x, y, z)
+			if math.Abs(lon-135) > 1e-9 && math.Abs(lat) != 90 || math.Abs(lat2-lat) > 1e-11 || math.Abs(h2-h) > 1e-4 {
+				t.Fatal("Failed (3)", h, lat, lon, lat2, h2)
+			}
+		}
+	}
+}
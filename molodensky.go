@@ -0,0 +1,50 @@
+//nolint:nonamedreturns,varnamelen,gomnd
+package wgs84
+
+import "math"
+
+type molodensky struct {
+	source, target spheroid
+	tx, ty, tz     float64
+	abridged       bool
+}
+
+func (t molodensky) Forward(x, y, z float64) (x0, y0, z0 float64) {
+	lon, lat, h := xyzToLonLat(x, y, z, t.source.A(), t.source.Fi())
+	lon, lat, h = calcMolodensky(lon, lat, h, t.source, t.target, t.tx, t.ty, t.tz, t.abridged)
+
+	return lonLatToXYZ(lon, lat, h, t.target.A(), t.target.Fi())
+}
+
+func (t molodensky) Inverse(x0, y0, z0 float64) (x, y, z float64) {
+	lon, lat, h := xyzToLonLat(x0, y0, z0, t.target.A(), t.target.Fi())
+	lon, lat, h = calcMolodensky(lon, lat, h, t.target, t.source, -t.tx, -t.ty, -t.tz, t.abridged)
+
+	return lonLatToXYZ(lon, lat, h, t.source.A(), t.source.Fi())
+}
+
+// calcMolodensky implements the EPSG methods 9604 (Molodensky) and 9605
+// (Abridged Molodensky).
+func calcMolodensky(lon, lat, h float64, s, t spheroid, tx, ty, tz float64, abridged bool) (lon0, lat0, h0 float64) {
+	φ, λ := radian(lat), radian(lon)
+	da := t.A() - s.A()
+	df := t.f() - s.f()
+	sφ, cφ, sλ, cλ := math.Sin(φ), math.Cos(φ), math.Sin(λ), math.Cos(λ)
+	ρ := s.A() * (1 - s.e2()) / math.Pow(1-s.e2()*sφ*sφ, 1.5)
+	ν := _N(φ, s)
+
+	var dφ, dλ, dh float64
+
+	if abridged {
+		dφ = (-tx*sφ*cλ - ty*sφ*sλ + tz*cφ + (s.A()*df+s.f()*da)*math.Sin(2*φ)) / ρ
+		dλ = (-tx*sλ + ty*cλ) / (ν * cφ)
+		dh = tx*cφ*cλ + ty*cφ*sλ + tz*sφ + (s.A()*df+s.f()*da)*sφ*sφ - da
+	} else {
+		dφ = (-tx*sφ*cλ - ty*sφ*sλ + tz*cφ + da*ν*s.e2()*sφ*cφ/s.A() +
+			df*(ρ*s.A()/s.b()+ν*s.b()/s.A())*sφ*cφ) / (ρ + h)
+		dλ = (-tx*sλ + ty*cλ) / ((ν + h) * cφ)
+		dh = tx*cφ*cλ + ty*cφ*sλ + tz*sφ - da*s.A()/ν + df*s.b()/s.A()*ν*sφ*sφ
+	}
+
+	return degree(λ + dλ), degree(φ + dφ), h + dh
+}
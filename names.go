@@ -0,0 +1,247 @@
+//nolint:varnamelen,gomnd
+package wgs84
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// SearchResult is a ranked result of Repository.Search.
+type SearchResult struct {
+	Code  int
+	Name  string
+	Score float64
+}
+
+// AddName adds the name and aliases of an EPSG-Code to the Repository.
+func (r *Repository) AddName(c int, name string, aliases ...string) {
+	r.mutex.Lock()
+	if r.names == nil {
+		r.names = map[int]string{}
+	}
+
+	if r.aliases == nil {
+		r.aliases = map[int][]string{}
+	}
+
+	if name != "" {
+		r.names[c] = name
+	}
+
+	r.aliases[c] = append(r.aliases[c], aliases...)
+	r.mutex.Unlock()
+}
+
+// Name returns the name of an EPSG-Code or an empty string.
+func (r *Repository) Name(c int) string {
+	r.mutex.Lock()
+	name := r.names[c]
+	r.mutex.Unlock()
+
+	return name
+}
+
+// Aliases returns the aliases of an EPSG-Code.
+func (r *Repository) Aliases(c int) []string {
+	r.mutex.Lock()
+	aliases := append([]string(nil), r.aliases[c]...)
+	r.mutex.Unlock()
+
+	return aliases
+}
+
+// ByName returns the EPSG-Code of an exact name.
+func (r *Repository) ByName(name string) (int, bool) {
+	code, ok := 0, false
+
+	r.mutex.Lock()
+	for c, n := range r.names {
+		if n == name && (!ok || c < code) {
+			code, ok = c, true
+		}
+	}
+	r.mutex.Unlock()
+
+	return code, ok
+}
+
+// ByAlias returns the EPSG-Code of a case-insensitive name or alias.
+//
+// The lowest code is returned if several codes match.
+func (r *Repository) ByAlias(alias string) (int, bool) {
+	alias = strings.TrimSpace(alias)
+	code, ok := 0, false
+
+	match := func(c int, name string) {
+		if strings.EqualFold(name, alias) && (!ok || c < code) {
+			code, ok = c, true
+		}
+	}
+
+	r.mutex.Lock()
+	for c, n := range r.names {
+		match(c, n)
+	}
+
+	for c, aa := range r.aliases {
+		for _, a := range aa {
+			match(c, a)
+		}
+	}
+	r.mutex.Unlock()
+
+	return code, ok
+}
+
+// Search returns the EPSG-Codes with names or aliases similar to the query
+// ranked by a score between 0 and 1. Typos and missing words are tolerated.
+//
+// All codes of the Repository are searched, codes without a name only by
+// their number or aliases.
+func (r *Repository) Search(query string) []SearchResult {
+	q := tokens(query)
+	if len(q) == 0 {
+		return nil
+	}
+
+	number, err := strconv.Atoi(strings.Join(q, ""))
+	if err != nil {
+		number = -1
+	}
+
+	var results []SearchResult
+
+	r.mutex.Lock()
+	codes := make(map[int]bool, len(r.codes)+len(r.verticals))
+
+	for c := range r.codes {
+		codes[c] = true
+	}
+
+	for c := range r.verticals {
+		codes[c] = true
+	}
+
+	for c := range r.names {
+		codes[c] = true
+	}
+
+	for c := range codes {
+		name := r.names[c]
+		score := nameScore(q, tokens(name))
+
+		for _, a := range r.aliases[c] {
+			if s := nameScore(q, tokens(a)); s > score {
+				score = s
+			}
+		}
+
+		if c == number {
+			score = 1
+		}
+
+		if score >= 0.6 {
+			results = append(results, SearchResult{Code: c, Name: name, Score: score})
+		}
+	}
+	r.mutex.Unlock()
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+
+		return results[i].Code < results[j].Code
+	})
+
+	return results
+}
+
+// tokens splits a lowercase name into words and numbers.
+func tokens(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// nameScore returns the mean similarity of the query words to the best
+// matching words of a name with a small penalty for additional words.
+func nameScore(query, name []string) float64 {
+	if len(name) == 0 {
+		return 0
+	}
+
+	if strings.Join(query, " ") == strings.Join(name, " ") {
+		return 1
+	}
+
+	sum := 0.0
+
+	for _, q := range query {
+		best := 0.0
+
+		for _, n := range name {
+			s := 0.0
+
+			switch {
+			case q == n:
+				s = 1
+			case strings.HasPrefix(n, q) && len(q) > 1:
+				s = 0.9
+			default:
+				s = 1 - float64(levenshtein(q, n))/math.Max(float64(len([]rune(q))), float64(len([]rune(n))))
+			}
+
+			if s > best {
+				best = s
+			}
+		}
+
+		sum += best
+	}
+
+	extra := len(name) - len(query)
+	if extra < 0 {
+		extra = 0
+	}
+
+	return sum/float64(len(query))*0.98 - 0.01*float64(extra)
+}
+
+// levenshtein returns the edit distance of two words.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = prev[j-1] + cost
+			if prev[j]+1 < curr[j] {
+				curr[j] = prev[j] + 1
+			}
+
+			if curr[j-1]+1 < curr[j] {
+				curr[j] = curr[j-1] + 1
+			}
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
@@ -0,0 +1,509 @@
+//nolint:varnamelen,nonamedreturns,gomnd,exhaustivestruct,exhaustruct
+package wgs84
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrNoOperation is returned if the datums of two CoordinateReferenceSystems
+// can't be connected by Operations.
+var ErrNoOperation = errors.New("no applicable operation")
+
+// Operation is a transformation of geocentric coordinates from the datum with
+// the EPSG-Code Source to the datum with the EPSG-Code Target like
+// https://epsg.io/1776.
+//
+// The Accuracy is the stated accuracy in meters and negative if unknown. A
+// nil Area is applicable everywhere and a nil Transformation is a null
+// transformation.
+//
+// It implements the Transformation interface.
+type Operation struct {
+	Code           int
+	Name           string
+	Source         int
+	Target         int
+	Accuracy       float64
+	Area           Area
+	Transformation Transformation
+}
+
+// Contains returns true if the Operation is applicable at a geographic
+// location.
+func (op Operation) Contains(lon, lat float64) bool {
+	return op.Area == nil || op.Area.Contains(lon, lat)
+}
+
+// Forward transforms geocentric coordinates from the Source to the Target
+// datum.
+func (op Operation) Forward(x, y, z float64) (x0, y0, z0 float64) {
+	if op.Transformation == nil {
+		return x, y, z
+	}
+
+	return op.Transformation.Forward(x, y, z)
+}
+
+// Inverse transforms geocentric coordinates from the Target to the Source
+// datum.
+func (op Operation) Inverse(x0, y0, z0 float64) (x, y, z float64) {
+	if op.Transformation == nil {
+		return x0, y0, z0
+	}
+
+	return op.Transformation.Inverse(x0, y0, z0)
+}
+
+// Reverse returns the Operation from the Target to the Source datum.
+func (op Operation) Reverse() Operation {
+	reverse := op
+	reverse.Source, reverse.Target = op.Target, op.Source
+	reverse.Name = "Inverse of " + op.Name
+
+	if op.Transformation != nil {
+		reverse.Transformation = inverse{op.Transformation}
+	}
+
+	return reverse
+}
+
+type inverse struct {
+	t Transformation
+}
+
+func (i inverse) Forward(x, y, z float64) (x0, y0, z0 float64) {
+	return i.t.Inverse(x, y, z)
+}
+
+func (i inverse) Inverse(x0, y0, z0 float64) (x, y, z float64) {
+	return i.t.Forward(x0, y0, z0)
+}
+
+// AddOperation adds an Operation to the Repository. An Operation with the
+// same Code is replaced.
+func (r *Repository) AddOperation(op Operation) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i := range r.operations {
+		if op.Code != 0 && r.operations[i].Code == op.Code {
+			r.operations[i] = op
+
+			return
+		}
+	}
+
+	r.operations = append(r.operations, op)
+}
+
+// Operation returns the Operation of a specific EPSG-Code.
+func (r *Repository) Operation(c int) (Operation, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, op := range r.operations {
+		if op.Code == c {
+			return op, true
+		}
+	}
+
+	return Operation{}, false
+}
+
+// Operations returns the Operations from the source to the target datum
+// including the reversed Operations from the target to the source datum.
+// They are sorted by their accuracy, unknown accuracies last.
+func (r *Repository) Operations(source, target int) []Operation {
+	r.mutex.Lock()
+
+	var ops []Operation
+
+	for _, op := range r.operations {
+		switch {
+		case op.Source == source && op.Target == target:
+			ops = append(ops, op)
+		case op.Source == target && op.Target == source:
+			ops = append(ops, op.Reverse())
+		}
+	}
+
+	r.mutex.Unlock()
+
+	sortOperations(ops)
+
+	return ops
+}
+
+func sortOperations(ops []Operation) {
+	sort.SliceStable(ops, func(i, j int) bool {
+		ai, aj := ops[i].Accuracy, ops[j].Accuracy
+
+		switch {
+		case (ai < 0) != (aj < 0):
+			return aj < 0
+		case ai != aj:
+			return ai < aj
+		}
+
+		return ops[i].Code < ops[j].Code
+	})
+}
+
+// Plan is a sequence of Operations between the datums of two
+// CoordinateReferenceSystems selected by Repository.Plan.
+//
+// The Accuracy is the sum of the stated accuracies of the Operations in
+// meters and negative if one of them is unknown.
+//
+// It implements the Transformation interface between the datums.
+type Plan struct {
+	From       CoordinateReferenceSystem
+	To         CoordinateReferenceSystem
+	Operations []Operation
+	Accuracy   float64
+}
+
+// Forward transforms geocentric coordinates from the datum of From to the
+// datum of To.
+func (p Plan) Forward(x, y, z float64) (x0, y0, z0 float64) {
+	for _, op := range p.Operations {
+		x, y, z = op.Forward(x, y, z)
+	}
+
+	return x, y, z
+}
+
+// Inverse transforms geocentric coordinates from the datum of To to the
+// datum of From.
+func (p Plan) Inverse(x0, y0, z0 float64) (x, y, z float64) {
+	for i := len(p.Operations) - 1; i >= 0; i-- {
+		x0, y0, z0 = p.Operations[i].Inverse(x0, y0, z0)
+	}
+
+	return x0, y0, z0
+}
+
+// Transform provides the transformation from From to To through the
+// Operations of the Plan instead of the Transformations of the datums.
+func (p Plan) Transform() Func {
+	from, _, _ := planSystem(p.From)
+	to, _, _ := planSystem(p.To)
+
+	return func(a, b, c float64) (a2, b2, c2 float64) {
+		if from != nil {
+			a, b, c = from.ToWGS84(a, b, c)
+		}
+
+		a, b, c = p.Forward(a, b, c)
+
+		if to != nil {
+			a, b, c = to.FromWGS84(a, b, c)
+		}
+
+		return a, b, c
+	}
+}
+
+// SafeTransform provides the transformation from From to To through the
+// Operations of the Plan with the errors of SafeTransform.
+func (p Plan) SafeTransform() SafeFunc {
+	transform, check := p.Transform(), SafeTransform(p.From, p.To)
+
+	return func(a, b, c float64) (a2, b2, c2 float64, err error) {
+		if _, _, _, err := check(a, b, c); err != nil {
+			return 0, 0, 0, err
+		}
+
+		a, b, c = transform(a, b, c)
+
+		return a, b, c, nil
+	}
+}
+
+// PlanPoint selects the most accurate Operations between the datums of two
+// CoordinateReferenceSystems at a geographic WGS84 location. See Plan.
+func (r *Repository) PlanPoint(from, to CoordinateReferenceSystem, lon, lat float64) (Plan, error) {
+	return r.Plan(from, to, lon, lat, lon, lat)
+}
+
+// maxPlanOperations is the maximum number of Operations of a Plan.
+const maxPlanOperations = 3
+
+// Plan selects the most accurate Operations between the datums of two
+// CoordinateReferenceSystems that are applicable in a geographic WGS84
+// extent. Extents with a west longitude greater than the east longitude
+// cross the antimeridian.
+//
+// Direct Operations between the datums are considered as well as chains of
+// up to three Operations over other datums like WGS84 or ETRS89. Chains of
+// known accuracy are preferred over chains with an unknown accuracy, then
+// the smallest sum of accuracies and the fewest Operations win.
+//
+// The Transformations of the datums to WGS84 are always available as
+// Operations with the Code 0 and an unknown accuracy, so the Plan falls back
+// to the behavior of Transform if no Operation of the Repository applies.
+func (r *Repository) Plan(from, to CoordinateReferenceSystem, west, south, east, north float64) (Plan, error) {
+	if from == nil || to == nil {
+		return Plan{}, ErrNoCoordinateReferenceSystem
+	}
+
+	if bodyOf(from) != bodyOf(to) {
+		return Plan{}, ErrBodyMismatch
+	}
+
+	_, source, okSource := planSystem(from)
+	_, target, okTarget := planSystem(to)
+
+	if !okSource || !okTarget {
+		return Plan{}, ErrNoOperation
+	}
+
+	plan := Plan{From: from, To: to}
+
+	if source.Code != 0 && source.Code == target.Code {
+		return plan, nil
+	}
+
+	if east < west {
+		east += 360
+	}
+
+	samples := extentSamples(west, south, east, north)
+	start, goal := datumNode(source, -1), datumNode(target, -2)
+
+	r.mutex.Lock()
+	candidates := append([]Operation{}, r.operations...)
+	r.mutex.Unlock()
+
+	candidates = append(candidates, datumOperation(source, start), datumOperation(target, goal))
+	graph := map[int][]Operation{}
+
+	for _, op := range candidates {
+		if !coversSamples(op, samples) {
+			continue
+		}
+
+		graph[op.Source] = append(graph[op.Source], op)
+		graph[op.Target] = append(graph[op.Target], op.Reverse())
+	}
+
+	for node := range graph {
+		sortOperations(graph[node])
+	}
+
+	var best route
+
+	searchPlan(graph, start, goal, map[int]bool{start: true}, route{}, &best)
+
+	if best.ops == nil {
+		return Plan{}, ErrNoOperation
+	}
+
+	plan.Operations, plan.Accuracy = best.ops, best.accuracy
+	if best.unknown {
+		plan.Accuracy = -1
+	}
+
+	return plan, nil
+}
+
+type route struct {
+	ops      []Operation
+	accuracy float64
+	unknown  bool
+}
+
+func (a route) better(b route) bool {
+	switch {
+	case a.unknown != b.unknown:
+		return b.unknown
+	case a.accuracy != b.accuracy:
+		return a.accuracy < b.accuracy
+	}
+
+	return len(a.ops) < len(b.ops)
+}
+
+// searchPlan is a depth-first search of the best route from a node to the
+// goal with at most maxPlanOperations Operations.
+func searchPlan(graph map[int][]Operation, node, goal int, visited map[int]bool, current route, best *route) {
+	for _, op := range graph[node] {
+		if visited[op.Target] {
+			continue
+		}
+
+		next := route{
+			ops:      append(append([]Operation{}, current.ops...), op),
+			accuracy: current.accuracy,
+			unknown:  current.unknown || op.Accuracy < 0,
+		}
+
+		if op.Accuracy > 0 {
+			next.accuracy += op.Accuracy
+		}
+
+		if best.ops != nil && !next.better(*best) {
+			continue
+		}
+
+		if op.Target == goal {
+			*best = next
+
+			continue
+		}
+
+		if len(next.ops) < maxPlanOperations {
+			visited[op.Target] = true
+			searchPlan(graph, op.Target, goal, visited, next, best)
+			visited[op.Target] = false
+		}
+	}
+}
+
+// datumNode returns the EPSG-Code of a Datum or a placeholder for custom
+// datums.
+func datumNode(d Datum, placeholder int) int {
+	if d.Code == 0 {
+		return placeholder
+	}
+
+	return d.Code
+}
+
+// datumOperation returns the Transformation of a Datum to WGS84 as an
+// Operation of unknown accuracy.
+func datumOperation(d Datum, node int) Operation {
+	return Operation{
+		Name:           "Datum transformation to WGS 84",
+		Source:         node,
+		Target:         WGS84().Code,
+		Accuracy:       -1,
+		Transformation: d.Transformation,
+	}
+}
+
+func coversSamples(op Operation, samples [][2]float64) bool {
+	if op.Source == op.Target {
+		return false
+	}
+
+	for _, s := range samples {
+		if !op.Contains(s[0], s[1]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// planSystem returns a CoordinateReferenceSystem without the Transformation
+// of its Datum, so that ToWGS84 and FromWGS84 use geocentric coordinates of
+// the Datum, and the Datum itself.
+func planSystem(crs CoordinateReferenceSystem) (CoordinateReferenceSystem, Datum, bool) {
+	switch v := crs.(type) {
+	case GeographicReferenceSystem:
+		d := v.Datum
+		v.Datum.Transformation = nil
+
+		return v, d, true
+	case GeocentricReferenceSystem:
+		d := v.Datum
+		v.Datum.Transformation = nil
+
+		return v, d, true
+	case ProjectedReferenceSystem:
+		d := v.Datum
+		v.Datum.Transformation = nil
+
+		return v, d, true
+	case RotatedReferenceSystem:
+		d := v.Datum
+		v.Datum.Transformation = nil
+
+		return v, d, true
+	case TopocentricReferenceSystem:
+		d := v.Datum
+		v.Datum.Transformation = nil
+
+		return v, d, true
+	case PlanetocentricReferenceSystem:
+		d := v.Datum
+		v.Datum.Transformation = nil
+
+		return v, d, true
+	case PlanetographicReferenceSystem:
+		d := v.Datum
+		v.Datum.Transformation = nil
+
+		return v, d, true
+	case LocalReferenceSystem:
+		d := v.Base.Datum
+		v.Base.Datum.Transformation = nil
+
+		return v, d, true
+	case CompoundReferenceSystem:
+		h, d, ok := planSystem(v.Horizontal)
+		v.Horizontal = h
+
+		return v, d, ok
+	}
+
+	return crs, Datum{}, false
+}
+
+// epsgOperations returns the Operations of the EPSG Repository.
+func epsgOperations() []Operation {
+	return []Operation{
+		{
+			Code: 1149, Name: "ETRS89 to WGS 84 (1)", Source: 6258, Target: 6326, Accuracy: 1,
+			Area: ETRS89().Area,
+		},
+		{
+			Code: 1150, Name: "GDA94 to WGS 84 (1)", Source: 6283, Target: 6326, Accuracy: 3,
+			Area: GDA94().Area,
+		},
+		{
+			Code: 1188, Name: "NAD83 to WGS 84 (1)", Source: 6269, Target: 6326, Accuracy: 4,
+			Area: NAD83().Area,
+		},
+		{
+			Code: 1314, Name: "OSGB36 to WGS 84 (6)", Source: 6277, Target: 6326, Accuracy: 2,
+			Area: OSGB36().Area, Transformation: OSGB36().Transformation,
+		},
+		{
+			Code: 1565, Name: "NZGD2000 to WGS 84 (1)", Source: 6167, Target: 6326, Accuracy: 1,
+			Area: NZGD2000().Area,
+		},
+		{
+			Code: 1618, Name: "MGI to WGS 84 (3)", Source: 6312, Target: 6326, Accuracy: 1,
+			Area: MGI().Area, Transformation: MGI().Transformation,
+		},
+		{
+			Code: 1671, Name: "RGF93 to WGS 84 (1)", Source: 6171, Target: 6326, Accuracy: 1,
+			Area: RGF93().Area,
+		},
+		{
+			Code: 1776, Name: "DHDN to ETRS89 (2)", Source: 6314, Target: 6258, Accuracy: 3,
+			Area: DHDN2001().Area, Transformation: DHDN2001().Transformation,
+		},
+		{
+			Code: 8048, Name: "GDA94 to GDA2020 (1)", Source: 6283, Target: 1168, Accuracy: 0.01,
+			Area: GDA2020().Area,
+			// rotations converted from the coordinate frame convention
+			Transformation: helmert{
+				tx: 0.06155,
+				ty: -0.01087,
+				tz: -0.04019,
+				rx: 0.0394924,
+				ry: 0.0327221,
+				rz: 0.0328979,
+				ds: -0.009994,
+			},
+		},
+		{
+			Code: 8450, Name: "GDA2020 to WGS 84 (2)", Source: 1168, Target: 6326, Accuracy: 3,
+			Area: GDA2020().Area,
+		},
+	}
+}
@@ -0,0 +1,92 @@
+package wgs84_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestPlan(t *testing.T) {
+	t.Parallel()
+
+	epsg := wgs84.EPSG()
+
+	plan, err := epsg.PlanPoint(epsg.Code(4283), epsg.Code(7844), 151.2, -33.9)
+	if err != nil || len(plan.Operations) != 1 || plan.Operations[0].Code != 8048 || plan.Accuracy != 0.01 {
+		t.Fatal("Failed", plan, err)
+	}
+
+	x0, y0, z0 := wgs84.GDA94().XYZ().ToWGS84(wgs84.LonLat().ToWGS84(151.2, -33.9, 0))
+	x, y, z := plan.Forward(x0, y0, z0)
+
+	if d := math.Sqrt((x-x0)*(x-x0) + (y-y0)*(y-y0) + (z-z0)*(z-z0)); d < 1 || d > 2 {
+		t.Fatal("Failed (2)", d)
+	}
+
+	x, y, z = plan.Inverse(x, y, z)
+	if math.Abs(x-x0) > 1e-6 || math.Abs(y-y0) > 1e-6 || math.Abs(z-z0) > 1e-6 {
+		t.Fatal("Failed (3)", x-x0, y-y0, z-z0)
+	}
+
+	plan, err = epsg.Plan(epsg.Code(31467), epsg.Code(25832), 9, 49, 11, 51)
+	if err != nil || len(plan.Operations) != 1 || plan.Operations[0].Code != 1776 || plan.Accuracy != 3 {
+		t.Fatal("Failed (4)", plan, err)
+	}
+
+	east, north, _ := plan.Transform().Round(3)(3500000, 5540000, 0)
+	e, n, _ := epsg.Transform(31467, 25832).Round(3)(3500000, 5540000, 0)
+
+	if east != e || north != n {
+		t.Fatal("Failed (5)", east, north, e, n)
+	}
+
+	plan, err = epsg.Plan(epsg.Code(4314), epsg.Code(4258), 8, 49, 20, 51)
+	if err != nil || plan.Accuracy != -1 || plan.Operations[0].Code != 0 {
+		t.Fatal("Failed (6)", plan, err)
+	}
+
+	plan, err = epsg.PlanPoint(epsg.Code(4326), epsg.Code(25832), 9, 50)
+	if err != nil || len(plan.Operations) != 1 || plan.Operations[0].Name != "Inverse of ETRS89 to WGS 84 (1)" ||
+		plan.Accuracy != 1 {
+		t.Fatal("Failed (7)", plan, err)
+	}
+
+	plan, err = epsg.PlanPoint(wgs84.UTM(32, true), wgs84.LonLat(), 9, 50)
+	if err != nil || len(plan.Operations) != 0 || plan.Accuracy != 0 {
+		t.Fatal("Failed (8)", plan, err)
+	}
+
+	custom := wgs84.Helmert(6377397.155, 299.1528128, 582, 105, 414, 0, 0, 0, 0).LonLat()
+
+	plan, err = epsg.PlanPoint(custom, wgs84.LonLat(), 9, 50)
+	if err != nil || len(plan.Operations) != 1 || plan.Accuracy != -1 {
+		t.Fatal("Failed (9)", plan, err)
+	}
+
+	lon, lat, _ := plan.Transform().Round(9)(9, 50, 0)
+	lo, la, _ := wgs84.Transform(custom, wgs84.LonLat()).Round(9)(9, 50, 0)
+
+	if lon != lo || lat != la {
+		t.Fatal("Failed (10)", lon, lat, lo, la)
+	}
+
+	plan, _ = epsg.PlanPoint(epsg.Code(4314), epsg.Code(4326), 9, 50)
+	if _, _, _, err := plan.SafeTransform()(100, 50, 0); !errors.Is(err, wgs84.ErrOutOfBounds) {
+		t.Fatal("Failed (11)", err)
+	}
+
+	if _, err := epsg.PlanPoint(nil, wgs84.LonLat(), 9, 50); !errors.Is(err, wgs84.ErrNoCoordinateReferenceSystem) {
+		t.Fatal("Failed (12)", err)
+	}
+
+	if _, err := epsg.PlanPoint(wgs84.Mars2015().LonLat(), wgs84.LonLat(), 9, 50); !errors.Is(err, wgs84.ErrBodyMismatch) {
+		t.Fatal("Failed (13)", err)
+	}
+
+	ops := epsg.Operations(1168, 6283)
+	if len(ops) != 1 || ops[0].Code != 8048 || ops[0].Source != 1168 || ops[0].Name != "Inverse of GDA94 to GDA2020 (1)" {
+		t.Fatal("Failed (14)", ops)
+	}
+}
@@ -0,0 +1,648 @@
+//nolint:varnamelen,nonamedreturns,gomnd,exhaustivestruct,exhaustruct,ireturn
+package wgs84
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidPipeline is returned for pipelines that can't be parsed.
+var ErrInvalidPipeline = errors.New("invalid pipeline")
+
+// Pipeline is a Transformation through explicit steps like the pipelines of
+// PROJ. The Forward method applies the steps in order and the Inverse method
+// applies the inverse steps in reverse order.
+//
+// Any Transformation is a step, for example a Datum of Helmert or
+// RigorousHelmert, an Operation or another Pipeline. Unlike PROJ all
+// geographic coordinates are in degrees.
+type Pipeline []Transformation
+
+// Forward applies the steps of the Pipeline.
+func (p Pipeline) Forward(a, b, c float64) (a2, b2, c2 float64) {
+	for _, step := range p {
+		a, b, c = step.Forward(a, b, c)
+	}
+
+	return a, b, c
+}
+
+// Inverse applies the inverse steps of the Pipeline in reverse order.
+func (p Pipeline) Inverse(a, b, c float64) (a2, b2, c2 float64) {
+	for i := len(p) - 1; i >= 0; i-- {
+		a, b, c = p[i].Inverse(a, b, c)
+	}
+
+	return a, b, c
+}
+
+// Func provides the Forward method as a Func.
+func (p Pipeline) Func() Func {
+	return p.Forward
+}
+
+// InverseFunc provides the Inverse method as a Func.
+func (p Pipeline) InverseFunc() Func {
+	return p.Inverse
+}
+
+// Invert returns a step with swapped Forward and Inverse methods.
+func Invert(t Transformation) Transformation {
+	if i, ok := t.(inverse); ok {
+		return i.t
+	}
+
+	return inverse{t}
+}
+
+// AxisSwap returns a step that reorders and negates the axes like the
+// axisswap operation of PROJ. The order lists the 1-based input axes of the
+// output axes, negative for negated axes, for example 2,1 or 1,-2,3.
+func AxisSwap(order ...int) (Transformation, error) {
+	swap := axisSwap{1, 2, 3}
+	used := [4]bool{}
+
+	if len(order) < 2 || len(order) > 3 {
+		return nil, fmt.Errorf("%w: axis order %v", ErrInvalidPipeline, order)
+	}
+
+	for i, o := range order {
+		axis := o
+		if axis < 0 {
+			axis = -axis
+		}
+
+		if axis < 1 || axis > len(order) || used[axis] {
+			return nil, fmt.Errorf("%w: axis order %v", ErrInvalidPipeline, order)
+		}
+
+		used[axis], swap[i] = true, o
+	}
+
+	return swap, nil
+}
+
+type axisSwap [3]int
+
+func (s axisSwap) Forward(a, b, c float64) (a2, b2, c2 float64) {
+	in := [3]float64{a, b, c}
+	out := [3]float64{}
+
+	for i, o := range s {
+		if o < 0 {
+			out[i] = -in[-o-1]
+		} else {
+			out[i] = in[o-1]
+		}
+	}
+
+	return out[0], out[1], out[2]
+}
+
+func (s axisSwap) Inverse(a, b, c float64) (a2, b2, c2 float64) {
+	in := [3]float64{a, b, c}
+	out := [3]float64{}
+
+	for i, o := range s {
+		if o < 0 {
+			out[-o-1] = -in[i]
+		} else {
+			out[o-1] = in[i]
+		}
+	}
+
+	return out[0], out[1], out[2]
+}
+
+// UnitConversion returns a step that multiplies the horizontal coordinates
+// by the factor xy and the vertical coordinate by the factor z.
+func UnitConversion(xy, z float64) Transformation {
+	return unitConversion{xy: xy, z: z}
+}
+
+type unitConversion struct {
+	xy, z float64
+}
+
+func (u unitConversion) Forward(a, b, c float64) (a2, b2, c2 float64) {
+	return a * u.xy, b * u.xy, c * u.z
+}
+
+func (u unitConversion) Inverse(a, b, c float64) (a2, b2, c2 float64) {
+	return a / u.xy, b / u.xy, c / u.z
+}
+
+// Cartesian returns a step from geographic longitudes, latitudes and
+// ellipsoidal heights to geocentric coordinates on a Spheroid.
+func Cartesian(s Spheroid) Transformation {
+	return cartesian{s: s}
+}
+
+type cartesian struct {
+	s Spheroid
+}
+
+func (c cartesian) Forward(lon, lat, h float64) (x, y, z float64) {
+	return lonLatToXYZ(lon, lat, h, c.s.A(), c.s.Fi())
+}
+
+func (c cartesian) Inverse(x, y, z float64) (lon, lat, h float64) {
+	return xyzToLonLat(x, y, z, c.s.A(), c.s.Fi())
+}
+
+// ProjectionStep returns a step from geographic longitudes and latitudes to
+// projected coordinates of a Projection on a Spheroid. The third coordinate
+// is kept.
+func ProjectionStep(p Projection, s Spheroid) Transformation {
+	return projectionStep{p: p, s: s}
+}
+
+type projectionStep struct {
+	p Projection
+	s Spheroid
+}
+
+func (p projectionStep) Forward(lon, lat, h float64) (east, north, h2 float64) {
+	east, north = p.p.FromLonLat(lon, lat, p.s)
+
+	return east, north, h
+}
+
+func (p projectionStep) Inverse(east, north, h float64) (lon, lat, h2 float64) {
+	lon, lat = p.p.ToLonLat(east, north, p.s)
+
+	return lon, lat, h
+}
+
+// GeoidShift returns a step from ellipsoidal heights to heights above a
+// Geoid at geographic longitudes and latitudes.
+func GeoidShift(g Geoid) Transformation {
+	return geoidShift{g: g}
+}
+
+type geoidShift struct {
+	g Geoid
+}
+
+func (g geoidShift) Forward(lon, lat, h float64) (lon2, lat2, height float64) {
+	return lon, lat, h - g.g.Undulation(lon, lat)
+}
+
+func (g geoidShift) Inverse(lon, lat, height float64) (lon2, lat2, h float64) {
+	return lon, lat, height + g.g.Undulation(lon, lat)
+}
+
+// geoidGrids uses the first Geoid with an undulation at a coordinate.
+type geoidGrids []Geoid
+
+func (g geoidGrids) Undulation(lon, lat float64) float64 {
+	for _, geoid := range g {
+		if n := geoid.Undulation(lon, lat); !math.IsNaN(n) {
+			return n
+		}
+	}
+
+	return math.NaN()
+}
+
+// ParsePipeline parses a Pipeline in the syntax of PROJ pipelines. Lines
+// starting with # are comments, so pipelines can be kept in config files.
+//
+//	# DHDN / 3-degree Gauss-Kruger zone 3 to ETRS89 / UTM zone 32N
+//	+proj=pipeline
+//	+step +inv +proj=tmerc +lon_0=9 +x_0=3500000 +ellps=bessel
+//	+step +proj=cart +ellps=bessel
+//	+step +proj=helmert +x=598.1 +y=73.7 +z=418.2 +rx=0.202 +ry=0.045
+//	      +rz=-2.455 +s=6.7 +convention=position_vector
+//	+step +inv +proj=cart +ellps=GRS80
+//	+step +proj=utm +zone=32 +ellps=GRS80
+//
+// Supported are the operations axisswap (order), unitconvert (xy_in, xy_out,
+// z_in, z_out), cart, helmert (x, y, z, rx, ry, rz, s, convention, exact),
+// tmerc (lon_0, lat_0, k_0, x_0, y_0), utm (zone, south), lcc and aea (lon_0,
+// lat_0, lat_1, lat_2, x_0, y_0), laea (lon_0, lat_0, x_0, y_0), webmerc,
+// vgridshift (grids) and noop. Ellipsoids of cart and the projections are
+// given by ellps or by a with rf or b and default to WGS84, and units sets
+// the unit of the projected coordinates. Grids prefixed by @ are optional
+// and skipped if they can't be loaded, further grids are used outside of
+// the first one. Parameters before the first step apply to all steps that
+// support them and +inv inverts a step or the Pipeline. Unknown parameters
+// return ErrInvalidPipeline.
+//
+// A string without steps is parsed as a Pipeline of one step.
+func ParsePipeline(s string) (Pipeline, error) {
+	var lines []string
+
+	for _, line := range strings.Split(s, "\n") {
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		lines = append(lines, line)
+	}
+
+	global := pipelineParams{}
+	steps := []pipelineParams{}
+	current := global
+
+	for _, token := range strings.Fields(strings.Join(lines, " ")) {
+		token = strings.TrimPrefix(token, "+")
+		if token == "step" {
+			current = pipelineParams{}
+			steps = append(steps, current)
+
+			continue
+		}
+
+		key, value, _ := strings.Cut(token, "=")
+		current[key] = value
+	}
+
+	if len(steps) == 0 {
+		if global["proj"] == "pipeline" {
+			return nil, fmt.Errorf("%w: no steps", ErrInvalidPipeline)
+		}
+
+		steps, global = []pipelineParams{global}, pipelineParams{}
+	} else if proj, ok := global["proj"]; ok && proj != "pipeline" {
+		return nil, fmt.Errorf("%w: unexpected proj=%s", ErrInvalidPipeline, proj)
+	}
+
+	if err := checkParams(global, steps); err != nil {
+		return nil, err
+	}
+
+	pipeline := make(Pipeline, 0, len(steps))
+
+	for _, params := range steps {
+		for key, value := range global {
+			if _, ok := params[key]; !ok && key != "proj" && key != "inv" && params.allows(key) {
+				params[key] = value
+			}
+		}
+
+		step, err := params.step()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := params["inv"]; ok {
+			step = Invert(step)
+		}
+
+		pipeline = append(pipeline, step)
+	}
+
+	if _, ok := global["inv"]; ok {
+		return Pipeline{Invert(pipeline)}, nil
+	}
+
+	return pipeline, nil
+}
+
+type pipelineParams map[string]string
+
+//nolint:gochecknoglobals
+var (
+	ellipsoidParams  = []string{"ellps", "a", "rf", "b"}
+	projectionParams = append([]string{"units"}, ellipsoidParams...)
+	pipelineAllowed  = map[string][]string{
+		"noop":        nil,
+		"axisswap":    {"order"},
+		"unitconvert": {"xy_in", "xy_out", "z_in", "z_out"},
+		"cart":        ellipsoidParams,
+		"helmert":     {"x", "y", "z", "rx", "ry", "rz", "s", "convention", "exact"},
+		"tmerc":       append([]string{"lon_0", "lat_0", "k_0", "k", "x_0", "y_0"}, projectionParams...),
+		"utm":         append([]string{"zone", "south"}, projectionParams...),
+		"lcc":         append([]string{"lon_0", "lat_0", "lat_1", "lat_2", "x_0", "y_0"}, projectionParams...),
+		"aea":         append([]string{"lon_0", "lat_0", "lat_1", "lat_2", "x_0", "y_0"}, projectionParams...),
+		"laea":        append([]string{"lon_0", "lat_0", "x_0", "y_0"}, projectionParams...),
+		"webmerc":     projectionParams,
+		"vgridshift":  {"grids"},
+	}
+)
+
+// allows reports whether the operation of the step supports the parameter.
+func (p pipelineParams) allows(key string) bool {
+	if key == "proj" || key == "inv" {
+		return true
+	}
+
+	for _, k := range pipelineAllowed[p["proj"]] {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkParams returns ErrInvalidPipeline for parameters of a step that its
+// operation doesn't support and for global parameters that no operation
+// supports.
+func checkParams(global pipelineParams, steps []pipelineParams) error {
+	for _, params := range steps {
+		if _, ok := pipelineAllowed[params["proj"]]; !ok {
+			continue
+		}
+
+		for key := range params {
+			if !params.allows(key) {
+				return fmt.Errorf("%w: unsupported parameter %s for proj=%s", ErrInvalidPipeline, key, params["proj"])
+			}
+		}
+	}
+
+	for key := range global {
+		allowed := key == "proj" || key == "inv"
+
+		for proj := range pipelineAllowed {
+			allowed = allowed || pipelineParams{"proj": proj}.allows(key)
+		}
+
+		if !allowed {
+			return fmt.Errorf("%w: unsupported parameter %s", ErrInvalidPipeline, key)
+		}
+	}
+
+	return nil
+}
+
+func (p pipelineParams) step() (Transformation, error) {
+	switch proj := p["proj"]; proj {
+	case "noop":
+		return Pipeline{}, nil
+	case "axisswap":
+		var order []int
+
+		for _, o := range strings.Split(p["order"], ",") {
+			i, err := strconv.Atoi(strings.TrimSpace(o))
+			if err != nil {
+				return nil, fmt.Errorf("%w: axis order %s", ErrInvalidPipeline, p["order"])
+			}
+
+			order = append(order, i)
+		}
+
+		return AxisSwap(order...)
+	case "unitconvert":
+		xy, err := unitFactor(p["xy_in"], p["xy_out"])
+		if err != nil {
+			return nil, err
+		}
+
+		z, err := unitFactor(p["z_in"], p["z_out"])
+		if err != nil {
+			return nil, err
+		}
+
+		return UnitConversion(xy, z), nil
+	case "cart":
+		s, err := p.spheroid()
+		if err != nil {
+			return nil, err
+		}
+
+		return Cartesian(s), nil
+	case "helmert":
+		return p.helmert()
+	case "tmerc", "utm", "lcc", "aea", "laea", "webmerc":
+		s, err := p.spheroid()
+		if err != nil {
+			return nil, err
+		}
+
+		projection, err := p.projection(proj, Datum{Spheroid: s})
+		if err != nil {
+			return nil, err
+		}
+
+		if units, ok := p["units"]; ok {
+			factor, err := unitFactor("m", units)
+			if err != nil {
+				return nil, err
+			}
+
+			return Pipeline{ProjectionStep(projection, s), UnitConversion(factor, 1)}, nil
+		}
+
+		return ProjectionStep(projection, s), nil
+	case "vgridshift":
+		return p.grids()
+	case "":
+		return nil, fmt.Errorf("%w: missing proj", ErrInvalidPipeline)
+	}
+
+	return nil, fmt.Errorf("%w: unsupported proj=%s", ErrInvalidPipeline, p["proj"])
+}
+
+func (p pipelineParams) grids() (Transformation, error) {
+	var grids geoidGrids
+
+	for _, path := range strings.Split(p["grids"], ",") {
+		optional := strings.HasPrefix(path, "@")
+
+		g, err := LoadGeoidGrid(strings.TrimPrefix(path, "@"))
+		if err != nil {
+			if optional {
+				continue
+			}
+
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPipeline, err.Error())
+		}
+
+		grids = append(grids, g)
+	}
+
+	switch len(grids) {
+	case 0:
+		return nil, fmt.Errorf("%w: no grids of %s", ErrInvalidPipeline, p["grids"])
+	case 1:
+		return GeoidShift(grids[0]), nil
+	}
+
+	return GeoidShift(grids), nil
+}
+
+func (p pipelineParams) float(key string, def float64) (float64, error) {
+	value, ok := p[key]
+	if !ok {
+		return def, nil
+	}
+
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s=%s", ErrInvalidPipeline, key, value)
+	}
+
+	return v, nil
+}
+
+func (p pipelineParams) floats(keys ...string) ([]float64, error) {
+	values := make([]float64, len(keys))
+
+	for i, key := range keys {
+		v, err := p.float(key, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = v
+	}
+
+	return values, nil
+}
+
+func (p pipelineParams) spheroid() (Spheroid, error) {
+	if _, ok := p["a"]; ok {
+		v, err := p.floats("a", "rf", "b")
+		if err != nil {
+			return nil, err
+		}
+
+		switch a, rf, b := v[0], v[1], v[2]; {
+		case rf != 0:
+			return spheroid{a: a, fi: rf}, nil
+		case b == a || b == 0:
+			return spheroid{a: a, fi: math.Inf(1)}, nil
+		default:
+			return spheroid{a: a, fi: a / (a - b)}, nil
+		}
+	}
+
+	ellps, ok := p["ellps"]
+	if !ok {
+		return spheroid{a: A, fi: Fi}, nil
+	}
+
+	s, ok := map[string]Spheroid{
+		"WGS84":     spheroid{a: A, fi: Fi},
+		"GRS80":     GRS80{},
+		"airy":      Airy{},
+		"bessel":    Bessel{},
+		"clrk66":    Clarke1866{},
+		"clrk80":    Clarke1880{},
+		"clrk80ign": Clarke1880IGN{},
+		"evrst30":   Everest1830{},
+		"helmert":   Helmert1906{},
+		"intl":      International1924{},
+		"krass":     Krassowsky1940{},
+		"WGS72":     WGS72Spheroid{},
+	}[ellps]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported ellps=%s", ErrInvalidPipeline, ellps)
+	}
+
+	return s, nil
+}
+
+func (p pipelineParams) helmert() (Transformation, error) {
+	v, err := p.floats("x", "y", "z", "rx", "ry", "rz", "s")
+	if err != nil {
+		return nil, err
+	}
+
+	tx, ty, tz, rx, ry, rz, ds := v[0], v[1], v[2], v[3], v[4], v[5], v[6]
+
+	var convention HelmertConvention
+
+	switch c := p["convention"]; {
+	case c == "position_vector":
+		convention = PositionVector
+	case c == "coordinate_frame":
+		convention = CoordinateFrame
+	case c == "" && rx == 0 && ry == 0 && rz == 0:
+	default:
+		return nil, fmt.Errorf("%w: helmert convention %q", ErrInvalidPipeline, c)
+	}
+
+	if _, ok := p["exact"]; ok {
+		return newRigorousHelmert(tx, ty, tz, rx, ry, rz, ds, convention), nil
+	}
+
+	if convention == CoordinateFrame {
+		rx, ry, rz = -rx, -ry, -rz
+	}
+
+	return helmert{tx: tx, ty: ty, tz: tz, rx: rx, ry: ry, rz: rz, ds: ds}, nil
+}
+
+func (p pipelineParams) projection(proj string, d Datum) (Projection, error) {
+	k0 := "k_0"
+	if _, ok := p["k"]; ok {
+		k0 = "k"
+	}
+
+	v, err := p.floats("lon_0", "lat_0", "lat_1", "lat_2", "x_0", "y_0")
+	if err != nil {
+		return nil, err
+	}
+
+	scale, err := p.float(k0, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	lon0, lat0, lat1, lat2, x0, y0 := v[0], v[1], v[2], v[3], v[4], v[5]
+	if _, ok := p["lat_2"]; !ok {
+		lat2 = lat1
+	}
+
+	switch proj {
+	case "tmerc":
+		return d.TransverseMercator(lon0, lat0, scale, x0, y0).Projection, nil
+	case "utm":
+		zone, err := p.float("zone", 0)
+		if err != nil || zone < 1 || zone > 60 || zone != math.Trunc(zone) {
+			return nil, fmt.Errorf("%w: utm zone=%s", ErrInvalidPipeline, p["zone"])
+		}
+
+		_, south := p["south"]
+
+		return UTM(zone, !south).Projection, nil
+	case "lcc":
+		return d.LambertConformalConic2SP(lon0, lat0, lat1, lat2, x0, y0).Projection, nil
+	case "aea":
+		return d.AlbersEqualAreaConic(lon0, lat0, lat1, lat2, x0, y0).Projection, nil
+	case "laea":
+		return d.LambertAzimuthalEqualArea(lon0, lat0, x0, y0).Projection, nil
+	}
+
+	return d.WebMercator().Projection, nil
+}
+
+// unitFactor returns the factor between two units of length or angle. Empty
+// units are meters or degrees.
+func unitFactor(in, out string) (float64, error) {
+	units := map[string]struct {
+		factor float64
+		angle  bool
+	}{
+		"":      {1, false},
+		"m":     {1, false},
+		"km":    {1000, false},
+		"dm":    {0.1, false},
+		"cm":    {0.01, false},
+		"mm":    {0.001, false},
+		"ft":    {0.3048, false},
+		"us-ft": {1200.0 / 3937, false},
+		"deg":   {1, true},
+		"rad":   {180 / math.Pi, true},
+		"grad":  {0.9, true},
+	}
+
+	i, okIn := units[in]
+	o, okOut := units[out]
+
+	switch {
+	case !okIn || !okOut:
+		return 0, fmt.Errorf("%w: units %q and %q", ErrInvalidPipeline, in, out)
+	case in != "" && out != "" && i.angle != o.angle:
+		return 0, fmt.Errorf("%w: units %q and %q", ErrInvalidPipeline, in, out)
+	}
+
+	return i.factor / o.factor, nil
+}
@@ -0,0 +1,149 @@
+//nolint:varnamelen
+package wgs84_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestPipeline(t *testing.T) {
+	t.Parallel()
+
+	p, err := wgs84.ParsePipeline(`
+		# DHDN / 3-degree Gauss-Kruger zone 3 to ETRS89 / UTM zone 32N
+		+proj=pipeline
+		+step +inv +proj=tmerc +lon_0=9 +x_0=3500000 +ellps=bessel
+		+step +proj=cart +ellps=bessel
+		+step +proj=helmert +x=598.1 +y=73.7 +z=418.2 +rx=0.202 +ry=0.045
+		      +rz=-2.455 +s=6.7 +convention=position_vector
+		+step +inv +proj=cart +ellps=GRS80
+		+step +proj=utm +zone=32 +ellps=GRS80`)
+	if err != nil || len(p) != 5 {
+		t.Fatal("Failed", p, err)
+	}
+
+	east, north, _ := p.Func().Round(3)(3500000, 5540000, 0)
+	e, n, _ := wgs84.EPSG().Transform(31467, 25832).Round(3)(3500000, 5540000, 0)
+
+	if east != e || north != n {
+		t.Fatal("Failed (2)", east, north, e, n)
+	}
+
+	east, north, _ = p.InverseFunc()(p.Forward(3500000, 5540000, 0))
+	if math.Abs(east-3500000) > 0.01 || math.Abs(north-5540000) > 0.01 {
+		t.Fatal("Failed (3)", east, north)
+	}
+
+	p, err = wgs84.ParsePipeline(`+proj=pipeline +ellps=GRS80 +inv
+		+step +proj=axisswap +order=2,1
+		+step +proj=unitconvert +xy_in=deg +xy_out=rad +z_in=m +z_out=mm`)
+	if err != nil {
+		t.Fatal("Failed (4)", err)
+	}
+
+	lon, lat, h := p.Func()(math.Pi/4, math.Pi/2, 1000)
+	if lon != 90 || lat != 45 || h != 1 {
+		t.Fatal("Failed (5)", lon, lat, h)
+	}
+
+	swap, err := wgs84.AxisSwap(1, -3, 2)
+	if err != nil {
+		t.Fatal("Failed (6)", err)
+	}
+
+	if a, b, c := swap.Forward(1, 2, 3); a != 1 || b != -3 || c != 2 {
+		t.Fatal("Failed (7)", a, b, c)
+	}
+
+	if a, b, c := wgs84.Invert(swap).Forward(1, -3, 2); a != 1 || b != 2 || c != 3 {
+		t.Fatal("Failed (8)", a, b, c)
+	}
+
+	dir := t.TempDir()
+
+	var buf bytes.Buffer
+
+	_ = binary.Write(&buf, binary.BigEndian, []float64{50, 5, 1, 1})
+	_ = binary.Write(&buf, binary.BigEndian, []int32{2, 2})
+	_ = binary.Write(&buf, binary.BigEndian, []float32{40, 42, 44, 46})
+
+	if err := os.WriteFile(filepath.Join(dir, "geoid.gtx"), buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err = wgs84.ParsePipeline("+proj=vgridshift +grids=" + filepath.Join(dir, "geoid.gtx"))
+	if err != nil || len(p) != 1 {
+		t.Fatal("Failed (9)", p, err)
+	}
+
+	if _, _, height := p.Forward(5, 50, 100); height != 60 {
+		t.Fatal("Failed (10)", height)
+	}
+
+	buf.Reset()
+
+	_ = binary.Write(&buf, binary.BigEndian, []float64{0, 0, 1, 1})
+	_ = binary.Write(&buf, binary.BigEndian, []int32{2, 2})
+	_ = binary.Write(&buf, binary.BigEndian, []float32{10, 10, 10, 10})
+
+	if err := os.WriteFile(filepath.Join(dir, "world.gtx"), buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err = wgs84.ParsePipeline("+proj=vgridshift +grids=@" + filepath.Join(dir, "missing.gtx") +
+		",@" + filepath.Join(dir, "geoid.gtx") + "," + filepath.Join(dir, "world.gtx"))
+	if err != nil {
+		t.Fatal("Failed (11)", err)
+	}
+
+	if _, _, height := p.Forward(5, 50, 100); height != 60 {
+		t.Fatal("Failed (12)", height)
+	}
+
+	if _, _, height := p.Forward(0.5, 0.5, 100); height != 90 {
+		t.Fatal("Failed (13)", height)
+	}
+
+	p, err = wgs84.ParsePipeline("+proj=utm +zone=32 +ellps=GRS80 +units=us-ft")
+	if err != nil {
+		t.Fatal("Failed (14)", err)
+	}
+
+	east, north, _ = p.Forward(9, 50, 0)
+	if math.Abs(east-500000*3937.0/1200) > 0.01 || math.Abs(north-5538630.7027*3937.0/1200) > 0.01 {
+		t.Fatal("Failed (15)", east, north)
+	}
+
+	for _, s := range []string{
+		"",
+		"+proj=pipeline",
+		"+proj=unknown",
+		"+proj=tmerc +lon_0=nine",
+		"+proj=utm +zone=61",
+		"+proj=helmert +x=1 +rx=1",
+		"+proj=axisswap +order=1,1",
+		"+proj=unitconvert +xy_in=m +xy_out=deg",
+		"+proj=cart +ellps=unknown",
+		"+proj=tmerc +step +proj=cart",
+		"+proj=tmerc +lon0=9",
+		"+proj=tmerc +lat_ts=50",
+		"+proj=tmerc +units=deg",
+		"+proj=utm +zone=32 +datum=potsdam",
+		"+proj=cart +towgs84=598.1,73.7,418.2",
+		"+proj=helmert +x=1 +multiplier=2",
+		"+proj=pipeline +lon0=9 +step +proj=tmerc",
+		"+proj=vgridshift +grids=@" + filepath.Join(dir, "missing.gtx"),
+		"+proj=vgridshift +grids=" + filepath.Join(dir, "missing.gtx") + ",@" + filepath.Join(dir, "geoid.gtx"),
+	} {
+		if _, err := wgs84.ParsePipeline(s); !errors.Is(err, wgs84.ErrInvalidPipeline) {
+			t.Fatal("Failed (16)", s, err)
+		}
+	}
+}
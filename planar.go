@@ -0,0 +1,134 @@
+//nolint:varnamelen,nonamedreturns,gomnd
+package wgs84
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrInvalidPolynomial is returned for polynomial coefficients beyond the
+// supported terms.
+var ErrInvalidPolynomial = errors.New("invalid polynomial coefficients")
+
+// ErrSingularTransformation is returned for similarity and affine
+// transformations without an inverse.
+var ErrSingularTransformation = errors.New("singular planar transformation")
+
+type similarity struct {
+	tx, ty, rotation, scale float64
+}
+
+// newSimilarity rejects zero and non-finite scale factors.
+func newSimilarity(tx, ty, rotation, scale float64) (similarity, error) {
+	if scale == 0 || math.IsNaN(scale) || math.IsInf(scale, 0) {
+		return similarity{}, fmt.Errorf("%w: scale %v", ErrSingularTransformation, scale)
+	}
+
+	return similarity{tx: tx, ty: ty, rotation: rotation, scale: scale}, nil
+}
+
+func (t similarity) Forward(x, y float64) (east, north float64) {
+	s, c := math.Sincos(radian(t.rotation))
+
+	return t.scale*(c*x-s*y) + t.tx, t.scale*(s*x+c*y) + t.ty
+}
+
+func (t similarity) Inverse(east, north float64) (x, y float64) {
+	s, c := math.Sincos(radian(t.rotation))
+	east, north = east-t.tx, north-t.ty
+
+	return (c*east + s*north) / t.scale, (c*north - s*east) / t.scale
+}
+
+type affine struct {
+	a0, a1, a2, b0, b1, b2 float64
+}
+
+// newAffine rejects matrices with a zero or non-finite determinant.
+func newAffine(a0, a1, a2, b0, b1, b2 float64) (affine, error) {
+	if d := a1*b2 - a2*b1; d == 0 || math.IsNaN(d) || math.IsInf(d, 0) {
+		return affine{}, fmt.Errorf("%w: determinant %v", ErrSingularTransformation, d)
+	}
+
+	return affine{a0: a0, a1: a1, a2: a2, b0: b0, b1: b1, b2: b2}, nil
+}
+
+func (t affine) Forward(x, y float64) (east, north float64) {
+	return t.a0 + t.a1*x + t.a2*y, t.b0 + t.b1*x + t.b2*y
+}
+
+func (t affine) Inverse(east, north float64) (x, y float64) {
+	d := t.a1*t.b2 - t.a2*t.b1
+	east, north = east-t.a0, north-t.b0
+
+	return (t.b2*east - t.a2*north) / d, (t.a1*north - t.b1*east) / d
+}
+
+// polynomial is a 2D polynomial transformation up to the 3rd order with the
+// terms 1, x, y, x², xy, y², x³, x²y, xy², y³.
+type polynomial struct {
+	a, b [10]float64
+}
+
+// newPolynomial pads the coefficients with zeros for the missing terms.
+func newPolynomial(a, b []float64) (polynomial, error) {
+	var t polynomial
+
+	if len(a) > len(t.a) || len(b) > len(t.b) {
+		return t, fmt.Errorf("%w: %d and %d coefficients for %d terms", ErrInvalidPolynomial, len(a), len(b), len(t.a))
+	}
+
+	copy(t.a[:], a)
+	copy(t.b[:], b)
+
+	return t, nil
+}
+
+func (t polynomial) terms(x, y float64) [10]float64 {
+	return [10]float64{1, x, y, x * x, x * y, y * y, x * x * x, x * x * y, x * y * y, y * y * y}
+}
+
+func (t polynomial) Forward(x, y float64) (east, north float64) {
+	terms := t.terms(x, y)
+
+	for i := range terms {
+		east += t.a[i] * terms[i]
+		north += t.b[i] * terms[i]
+	}
+
+	return east, north
+}
+
+func (t polynomial) jacobian(x, y float64) (ex, ey, nx, ny float64) {
+	dx := [10]float64{0, 1, 0, 2 * x, y, 0, 3 * x * x, 2 * x * y, y * y, 0}
+	dy := [10]float64{0, 0, 1, 0, x, 2 * y, 0, x * x, 2 * x * y, 3 * y * y}
+
+	for i := range dx {
+		ex += t.a[i] * dx[i]
+		ey += t.a[i] * dy[i]
+		nx += t.b[i] * dx[i]
+		ny += t.b[i] * dy[i]
+	}
+
+	return ex, ey, nx, ny
+}
+
+// Inverse solves the polynomial through Newton iterations.
+func (t polynomial) Inverse(east, north float64) (x, y float64) {
+	for i := 0; i < 20; i++ {
+		e, n := t.Forward(x, y)
+		ex, ey, nx, ny := t.jacobian(x, y)
+		d := ex*ny - ey*nx
+		dx := (ny*(east-e) - ey*(north-n)) / d
+		dy := (ex*(north-n) - nx*(east-e)) / d
+		x += dx
+		y += dy
+
+		if math.Abs(dx) < 1e-10 && math.Abs(dy) < 1e-10 {
+			break
+		}
+	}
+
+	return x, y
+}
@@ -0,0 +1,385 @@
+//nolint:varnamelen,gomnd
+package wgs84
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidArea is returned for unreadable or unsupported polygon areas.
+var ErrInvalidArea = errors.New("invalid area")
+
+// Polygon is an Area of rings of longitudes and latitudes. The first ring is
+// the exterior and all other rings are holes. Rings may be closed or open.
+//
+// Edges crossing the antimeridian are continued across it, so rings can use
+// longitudes like 179 and -179 or 179 and 181.
+type Polygon [][][2]float64
+
+// Contains method is the implementation of the Area interface.
+func (p Polygon) Contains(lon, lat float64) bool {
+	if len(p) == 0 || math.Abs(lat) > 90 || math.Abs(lon) > 180 || !inRing(p[0], lon, lat) {
+		return false
+	}
+
+	for _, hole := range p[1:] {
+		if inRing(hole, lon, lat) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Bounds returns the BoundingBox of the exterior ring.
+func (p Polygon) Bounds() BoundingBox {
+	if len(p) == 0 || len(p[0]) == 0 {
+		return BoundingBox{West: 0, South: 0, East: -360, North: -90}
+	}
+
+	ring := p[0]
+	x := ring[0][0]
+	b := BoundingBox{West: x, South: ring[0][1], East: x, North: ring[0][1]}
+
+	for i := 1; i < len(ring); i++ {
+		x += wrapLon(ring[i][0] - ring[i-1][0])
+		b.West, b.East = math.Min(b.West, x), math.Max(b.East, x)
+		b.South, b.North = math.Min(b.South, ring[i][1]), math.Max(b.North, ring[i][1])
+	}
+
+	if b.East-b.West >= 360 {
+		b.West, b.East = -180, 180
+	} else {
+		b.West, b.East = wrapLon(b.West), wrapLon(b.East)
+	}
+
+	return b
+}
+
+// MultiPolygon is an Area of several Polygons.
+type MultiPolygon []Polygon
+
+// Contains method is the implementation of the Area interface.
+func (m MultiPolygon) Contains(lon, lat float64) bool {
+	for _, p := range m {
+		if p.Contains(lon, lat) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Bounds returns the smallest BoundingBox of all Polygons.
+func (m MultiPolygon) Bounds() BoundingBox {
+	boxes := make([]BoundingBox, len(m))
+
+	for i, p := range m {
+		boxes[i] = p.Bounds()
+	}
+
+	return unionBounds(boxes)
+}
+
+// inRing is a ray casting test of a ring with unwrapped longitudes at the
+// longitude and its equivalents east and west of the antimeridian.
+func inRing(ring [][2]float64, lon, lat float64) bool {
+	if len(ring) < 3 {
+		return false
+	}
+
+	inside := [3]bool{}
+	x0, y0 := ring[0][0], ring[0][1]
+
+	for i := 1; i <= len(ring); i++ {
+		next := ring[i%len(ring)]
+		x1 := x0 + wrapLon(next[0]-ring[i-1][0])
+		y1 := next[1]
+
+		if (y0 > lat) != (y1 > lat) {
+			x := x0 + (x1-x0)*(lat-y0)/(y1-y0)
+
+			for j, l := range [3]float64{lon - 360, lon, lon + 360} {
+				if l < x {
+					inside[j] = !inside[j]
+				}
+			}
+		}
+
+		x0, y0 = x1, y1
+	}
+
+	return inside[0] || inside[1] || inside[2]
+}
+
+func wrapLon(d float64) float64 {
+	for d > 180 {
+		d -= 360
+	}
+
+	for d < -180 {
+		d += 360
+	}
+
+	return d
+}
+
+// LoadArea loads a MultiPolygon from a GeoJSON file or a WKT file with the
+// extension .wkt.
+func LoadArea(path string) (MultiPolygon, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".wkt") {
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return ParseWKT(string(data))
+	}
+
+	return ReadGeoJSON(f)
+}
+
+type geoJSON struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+	Geometry    *geoJSON        `json:"geometry"`
+	Geometries  []geoJSON       `json:"geometries"`
+	Features    []geoJSON       `json:"features"`
+}
+
+// ReadGeoJSON reads a MultiPolygon from the Polygons and MultiPolygons of a
+// GeoJSON Geometry, GeometryCollection, Feature or FeatureCollection.
+func ReadGeoJSON(r io.Reader) (MultiPolygon, error) {
+	var g geoJSON
+	if err := json.NewDecoder(r).Decode(&g); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArea, err.Error())
+	}
+
+	m, err := g.multiPolygon()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(m) == 0 {
+		return nil, fmt.Errorf("%w: no polygons", ErrInvalidArea)
+	}
+
+	return m, nil
+}
+
+func (g geoJSON) multiPolygon() (MultiPolygon, error) {
+	var m MultiPolygon
+
+	switch g.Type {
+	case "Polygon":
+		var coordinates [][][]float64
+		if err := json.Unmarshal(g.Coordinates, &coordinates); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidArea, err.Error())
+		}
+
+		p, err := polygon(coordinates)
+		if err != nil {
+			return nil, err
+		}
+
+		m = append(m, p)
+	case "MultiPolygon":
+		var coordinates [][][][]float64
+		if err := json.Unmarshal(g.Coordinates, &coordinates); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidArea, err.Error())
+		}
+
+		for _, c := range coordinates {
+			p, err := polygon(c)
+			if err != nil {
+				return nil, err
+			}
+
+			m = append(m, p)
+		}
+	case "Feature":
+		if g.Geometry != nil {
+			return g.Geometry.multiPolygon()
+		}
+	case "FeatureCollection", "GeometryCollection":
+		for _, f := range append(g.Features, g.Geometries...) {
+			fm, err := f.multiPolygon()
+			if err != nil {
+				return nil, err
+			}
+
+			m = append(m, fm...)
+		}
+	case "":
+		return nil, fmt.Errorf("%w: missing type", ErrInvalidArea)
+	}
+
+	return m, nil
+}
+
+func polygon(coordinates [][][]float64) (Polygon, error) {
+	p := make(Polygon, len(coordinates))
+
+	for i, ring := range coordinates {
+		p[i] = make([][2]float64, len(ring))
+
+		for j, position := range ring {
+			if len(position) < 2 {
+				return nil, fmt.Errorf("%w: invalid position", ErrInvalidArea)
+			}
+
+			p[i][j] = [2]float64{position[0], position[1]}
+		}
+	}
+
+	return p, nil
+}
+
+// ParseWKT parses a MultiPolygon from a WKT POLYGON or MULTIPOLYGON with
+// optional Z or M coordinates.
+func ParseWKT(s string) (MultiPolygon, error) {
+	s = strings.TrimSpace(s)
+	upper := strings.ToUpper(s)
+
+	open := strings.IndexByte(s, '(')
+	if open < 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArea, s)
+	}
+
+	w := &wkt{s: s, i: open}
+
+	switch kind := strings.Fields(upper[:open]); {
+	case len(kind) > 0 && kind[0] == "POLYGON":
+		p, err := w.polygon()
+		if err != nil {
+			return nil, err
+		}
+
+		return MultiPolygon{p}, w.end()
+	case len(kind) > 0 && kind[0] == "MULTIPOLYGON":
+		var m MultiPolygon
+
+		err := w.list(func() error {
+			p, err := w.polygon()
+			m = append(m, p)
+
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return m, w.end()
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrInvalidArea, s)
+}
+
+type wkt struct {
+	s string
+	i int
+}
+
+func (w *wkt) skip() {
+	for w.i < len(w.s) && strings.ContainsRune(" \t\r\n", rune(w.s[w.i])) {
+		w.i++
+	}
+}
+
+func (w *wkt) end() error {
+	w.skip()
+
+	if w.i != len(w.s) {
+		return fmt.Errorf("%w: unexpected %q", ErrInvalidArea, w.s[w.i:])
+	}
+
+	return nil
+}
+
+func (w *wkt) list(item func() error) error {
+	w.skip()
+
+	if w.i >= len(w.s) || w.s[w.i] != '(' {
+		return fmt.Errorf("%w: expected '('", ErrInvalidArea)
+	}
+
+	w.i++
+
+	for {
+		if err := item(); err != nil {
+			return err
+		}
+
+		w.skip()
+
+		if w.i >= len(w.s) {
+			return fmt.Errorf("%w: expected ')'", ErrInvalidArea)
+		}
+
+		w.i++
+
+		switch w.s[w.i-1] {
+		case ',':
+		case ')':
+			return nil
+		default:
+			return fmt.Errorf("%w: unexpected %q", ErrInvalidArea, w.s[w.i-1])
+		}
+	}
+}
+
+func (w *wkt) polygon() (Polygon, error) {
+	var p Polygon
+
+	err := w.list(func() error {
+		var ring [][2]float64
+
+		err := w.list(func() error {
+			end := strings.IndexAny(w.s[w.i:], ",)")
+			if end < 0 {
+				return fmt.Errorf("%w: expected ')'", ErrInvalidArea)
+			}
+
+			fields := strings.Fields(w.s[w.i : w.i+end])
+			w.i += end
+
+			if len(fields) < 2 {
+				return fmt.Errorf("%w: invalid position", ErrInvalidArea)
+			}
+
+			lon, err := strconv.ParseFloat(fields[0], 64)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrInvalidArea, err.Error())
+			}
+
+			lat, err := strconv.ParseFloat(fields[1], 64)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrInvalidArea, err.Error())
+			}
+
+			ring = append(ring, [2]float64{lon, lat})
+
+			return nil
+		})
+
+		p = append(p, ring)
+
+		return err
+	})
+
+	return p, err
+}
@@ -0,0 +1,196 @@
+//nolint:varnamelen
+package wgs84_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestPolygon(t *testing.T) {
+	t.Parallel()
+
+	p := wgs84.Polygon{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{4, 4}, {6, 4}, {6, 6}, {4, 6}},
+	}
+
+	if !p.Contains(2, 2) || p.Contains(5, 5) || p.Contains(11, 5) || p.Contains(2, 95) {
+		t.Fatal("Failed")
+	}
+
+	antimeridian := wgs84.Polygon{{{170, -10}, {-170, -10}, {-170, 10}, {170, 10}}}
+	unwrapped := wgs84.Polygon{{{170, -10}, {190, -10}, {190, 10}, {170, 10}}}
+
+	for _, a := range []wgs84.Area{antimeridian, unwrapped} {
+		if !a.Contains(175, 0) || !a.Contains(-175, 0) || !a.Contains(180, 0) || a.Contains(0, 0) || a.Contains(-160, 0) {
+			t.Fatal("Failed (2)")
+		}
+	}
+
+	m := wgs84.MultiPolygon{antimeridian, p}
+	if !m.Contains(-179, 5) || !m.Contains(1, 1) || m.Contains(5, 5) {
+		t.Fatal("Failed (3)")
+	}
+}
+
+func TestGeoJSON(t *testing.T) {
+	t.Parallel()
+
+	m, err := wgs84.ReadGeoJSON(strings.NewReader(`{
+		"type": "FeatureCollection",
+		"features": [
+			{"type": "Feature", "properties": {}, "geometry": {
+				"type": "Polygon", "coordinates": [[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]]]
+			}},
+			{"type": "Feature", "properties": {}, "geometry": {
+				"type": "MultiPolygon", "coordinates": [
+					[[[170, -10, 0], [180, -10, 0], [180, 10, 0], [170, 10, 0], [170, -10, 0]]],
+					[[[-180, -10], [-170, -10], [-170, 10], [-180, 10], [-180, -10]]]
+				]
+			}},
+			{"type": "Feature", "properties": {}, "geometry": {"type": "Point", "coordinates": [50, 50]}}
+		]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(m) != 3 || !m.Contains(5, 5) || !m.Contains(175, 0) || !m.Contains(-175, 0) || m.Contains(50, 50) {
+		t.Fatal("Failed", m)
+	}
+
+	for _, s := range []string{`{`, `{"type": "Point", "coordinates": [0, 0]}`, `{"type": "Polygon", "coordinates": [[[0]]]}`} {
+		if _, err := wgs84.ReadGeoJSON(strings.NewReader(s)); !errors.Is(err, wgs84.ErrInvalidArea) {
+			t.Fatal("Failed (2)", s, err)
+		}
+	}
+}
+
+func TestWKT(t *testing.T) {
+	t.Parallel()
+
+	p, err := wgs84.ParseWKT("POLYGON ((0 0, 10 0, 10 10, 0 10, 0 0), (4 4, 6 4, 6 6, 4 6, 4 4))")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !p.Contains(2, 2) || p.Contains(5, 5) {
+		t.Fatal("Failed")
+	}
+
+	m, err := wgs84.ParseWKT("MULTIPOLYGON Z (((170 -10 0, 190 -10 0, 190 10 0, 170 10 0)), ((0 0 1, 1 0 1, 1 1 1, 0 0 1)))")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(m) != 2 || !m.Contains(-175, 0) || m.Contains(-160, 0) {
+		t.Fatal("Failed (2)", m)
+	}
+
+	for _, s := range []string{"POINT (0 0)", "POLYGON EMPTY", "POLYGON ((0 0, 1 0, 1 1)", "POLYGON ((0 0, 1 0, 1 x))", "POLYGON ((0, 1 0, 1 1)) x"} {
+		if _, err := wgs84.ParseWKT(s); !errors.Is(err, wgs84.ErrInvalidArea) {
+			t.Fatal("Failed (3)", s, err)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "area.wkt")
+	if err := os.WriteFile(path, []byte("POLYGON ((0 0, 10 0, 10 10, 0 10))\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if a, err := wgs84.LoadArea(path); err != nil || !a.Contains(5, 5) {
+		t.Fatal("Failed (4)", err)
+	}
+}
+
+func TestBoundaries(t *testing.T) {
+	t.Parallel()
+
+	epsg := wgs84.EPSG()
+
+	for _, c := range []struct {
+		lon, lat float64
+		code     int
+		want     bool
+	}{
+		{9, 52, 4314, true},
+		{6, 54.5, 4314, false},
+		{5.97, 51.6, 4314, false},
+		{-0.13, 51.5, 27700, true},
+		{-5.93, 54.6, 27700, false},
+		{2.5, 54, 27700, false},
+		{16.37, 48.2, 31287, true},
+		{11.58, 48.14, 31287, false},
+		{2.35, 48.85, 2154, true},
+		{4.35, 50.85, 2154, false},
+		{7.885, 54.182, 4314, true},
+		{8.307, 54.907, 4314, true},
+		{8.35, 54.65, 4314, true},
+		{13.43, 54.4, 4314, true},
+		{7.75, 48.58, 4314, false},
+		{13.04, 47.8, 4314, false},
+		{8.87, 54.93, 4314, false},
+		{-1.15, 60.15, 27700, true},
+		{-4.5, 54.2, 27700, true},
+		{-6.3, 49.92, 27700, true},
+		{-6.26, 53.35, 27700, false},
+		{1.86, 50.95, 27700, false},
+		{9, 42.2, 2154, true},
+		{-5.08, 48.47, 2154, true},
+		{-1.3, 45.95, 2154, true},
+		{6.14, 46.2, 2154, false},
+		{4.8, 53.07, 4289, true},
+		{6.08, 50.78, 4289, false},
+		{10.36, 46.94, 4149, true},
+		{9.08, 45.81, 4149, false},
+		{10.45, 47.57, 31287, true},
+		{167.9, -46.9, 4272, true},
+		{-3.0553, 53.8159, 27700, true},
+		{-4.1419, 50.3644, 27700, true},
+		{-2.9958, 53.4058, 27700, true},
+		{-5.7155, 50.0659, 27700, true},
+		{0.1079, 49.4938, 2154, true},
+		{9.175, 47.66, 4314, true},
+		{9.175, 47.66, 31467, true},
+		{-176.56, -43.95, 4167, true},
+		{172.68, -34.43, 4272, true},
+		{-176.5, -43.85, 4167, true},
+		{-176.5, -43.85, 4272, false},
+		{170, -40, 4167, false},
+	} {
+		if epsg.Code(c.code).Contains(c.lon, c.lat) != c.want {
+			t.Fatal("Failed", c)
+		}
+	}
+
+	if _, _, _, err := epsg.SafeTransform(4326, 27700)(-3.0553, 53.8159, 0); err != nil {
+		t.Fatal("Failed (2)", err)
+	}
+}
+
+func TestBounds(t *testing.T) {
+	t.Parallel()
+
+	b := wgs84.Polygon{{{170, -10}, {-170, -10}, {-170, 10}, {170, 10}}}.Bounds()
+	if b != (wgs84.BoundingBox{West: 170, South: -10, East: -170, North: 10}) {
+		t.Fatal("Failed", b)
+	}
+
+	m := wgs84.MultiPolygon{
+		{{{166, -47}, {178, -47}, {178, -34}, {166, -34}}},
+		{{{-177, -44.5}, {-176, -44.5}, {-176, -43.5}, {-177, -43.5}}},
+	}
+
+	if b := m.Bounds(); b != (wgs84.BoundingBox{West: 166, South: -47, East: -176, North: -34}) {
+		t.Fatal("Failed (2)", b)
+	}
+
+	if !b.Contains(180, 0) || !b.Contains(-175, 0) || b.Contains(0, 0) || b.Contains(175, 20) {
+		t.Fatal("Failed (3)")
+	}
+}
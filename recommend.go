@@ -0,0 +1,274 @@
+//nolint:varnamelen,gomnd,exhaustivestruct,exhaustruct,asciicheck,nonamedreturns
+package wgs84
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Intent is the purpose of a recommended CoordinateReferenceSystem.
+type Intent int
+
+const (
+	// Conformal prefers systems that preserve angles and shapes with a small
+	// scale error.
+	Conformal Intent = iota
+
+	// EqualArea prefers systems that preserve areas with a small angular
+	// distortion.
+	EqualArea
+
+	// LocalAccuracy prefers systems with the smallest scale error regardless
+	// of angular or areal distortion.
+	LocalAccuracy
+)
+
+// Recommendation is a ranked CoordinateReferenceSystem of
+// Repository.Recommend.
+//
+// Scale, AreaScale and Angle are the maximum linear scale error, areal scale
+// error and angular distortion in radians of the Tissot indicatrix in the
+// extent. Coverage is the fraction of the extent within the area of use.
+// Custom systems have the Code 0. Lower costs are better.
+type Recommendation struct {
+	Code      int
+	Name      string
+	System    CoordinateReferenceSystem
+	Scale     float64
+	AreaScale float64
+	Angle     float64
+	Coverage  float64
+	Cost      float64
+}
+
+// suitableDistortion is the maximum distortion of a suitable system.
+const suitableDistortion = 1e-3
+
+// RecommendPoint returns the recommended CoordinateReferenceSystems of a
+// geographic WGS84 location.
+func (r *Repository) RecommendPoint(lon, lat float64, intent Intent) []Recommendation {
+	return r.Recommend(lon, lat, lon, lat, intent)
+}
+
+// Recommend ranks the projected CoordinateReferenceSystems covering a
+// geographic WGS84 extent by their expected distortion for the intent, the
+// coverage of the extent and the appropriateness of the datum. Extents with
+// a west longitude greater than the east longitude cross the antimeridian.
+//
+// Regional datums without a transformation to WGS84 are preferred over
+// global datums and datums with transformations.
+//
+// A custom Transverse Mercator or Lambert Azimuthal Equal Area system
+// centered on the extent is recommended first if no registered system
+// covers the extent with a distortion below 0.1 percent.
+func (r *Repository) Recommend(west, south, east, north float64, intent Intent) []Recommendation {
+	if east < west {
+		east += 360
+	}
+
+	samples := extentSamples(west, south, east, north)
+
+	var (
+		recommendations []Recommendation
+		best            *Recommendation
+	)
+
+	for _, c := range r.CodesCover(wrapLon((west+east)/2), (south+north)/2) {
+		crs, ok := r.Code(c).(ProjectedReferenceSystem)
+		if !ok {
+			continue
+		}
+
+		rec := recommendation(crs, samples, intent)
+		rec.Code, rec.Name = c, r.Name(c)
+
+		recommendations = append(recommendations, rec)
+	}
+
+	sort.Slice(recommendations, func(i, j int) bool {
+		if recommendations[i].Cost != recommendations[j].Cost {
+			return recommendations[i].Cost < recommendations[j].Cost
+		}
+
+		return recommendations[i].Code < recommendations[j].Code
+	})
+
+	if len(recommendations) > 0 {
+		best = &recommendations[0]
+	}
+
+	if best != nil && best.Coverage == 1 && distortion(*best, intent) <= suitableDistortion {
+		return recommendations
+	}
+
+	datum := WGS84()
+	if best != nil {
+		if crs, ok := best.System.(ProjectedReferenceSystem); ok {
+			datum = crs.Datum
+		}
+	}
+
+	custom := recommendation(customSystem(datum, west, south, east, north, intent), samples, intent)
+	custom.Name = fmt.Sprintf("Custom %s", intent)
+
+	return append([]Recommendation{custom}, recommendations...)
+}
+
+// String returns the name of the Intent.
+func (i Intent) String() string {
+	switch i {
+	case Conformal:
+		return "Conformal"
+	case EqualArea:
+		return "Equal Area"
+	case LocalAccuracy:
+		return "Local Accuracy"
+	}
+
+	return "Unknown"
+}
+
+// customSystem returns a Lambert Azimuthal Equal Area system for equal-area
+// intents and otherwise a Transverse Mercator system with a central scale
+// factor balancing the scale error in the extent.
+func customSystem(d Datum, west, south, east, north float64, intent Intent) ProjectedReferenceSystem {
+	lon0, lat0 := wrapLon((west+east)/2), (south+north)/2
+
+	var crs ProjectedReferenceSystem
+
+	if intent == EqualArea {
+		crs = d.LambertAzimuthalEqualArea(lon0, lat0, 0, 0)
+	} else {
+		lat := math.Min(math.Abs(south), math.Abs(north))
+		if south < 0 && north > 0 {
+			lat = 0
+		}
+
+		dl := radian((east - west) / 2 * math.Cos(radian(lat)))
+		crs = d.TransverseMercator(lon0, lat0, 1-dl*dl/4, 0, 0)
+	}
+
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		if lon < west {
+			lon += 360
+		}
+
+		return lon >= west && lon <= east && lat >= south && lat <= north
+	})
+
+	return crs
+}
+
+// extentSamples returns a grid of 5x5 locations in the extent.
+func extentSamples(west, south, east, north float64) [][2]float64 {
+	if west == east && south == north {
+		return [][2]float64{{wrapLon(west), south}}
+	}
+
+	const n = 5
+
+	samples := make([][2]float64, 0, n*n)
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			samples = append(samples, [2]float64{
+				wrapLon(west + (east-west)*float64(i)/(n-1)),
+				south + (north-south)*float64(j)/(n-1),
+			})
+		}
+	}
+
+	return samples
+}
+
+// recommendation estimates the distortion and coverage of a projected
+// system at the samples.
+func recommendation(crs ProjectedReferenceSystem, samples [][2]float64, intent Intent) Recommendation {
+	rec := Recommendation{System: crs}
+	toLocal := Transform(LonLat(), crs.Datum.LonLat())
+	covered := 0
+
+	for _, s := range samples {
+		if !crs.Contains(s[0], s[1]) {
+			continue
+		}
+
+		covered++
+
+		lon, lat, _ := toLocal(s[0], s[1], 0)
+		a, b := tissot(crs.Projection, crs.Datum, lon, lat)
+
+		rec.Scale = math.Max(rec.Scale, math.Max(math.Abs(a-1), math.Abs(b-1)))
+		rec.AreaScale = math.Max(rec.AreaScale, math.Abs(a*b-1))
+		rec.Angle = math.Max(rec.Angle, 2*math.Asin(math.Min(1, (a-b)/(a+b))))
+	}
+
+	rec.Coverage = float64(covered) / float64(len(samples))
+	rec.Cost = distortion(rec, intent) + (1 - rec.Coverage) + datumCost(crs.Datum, samples[0])
+
+	return rec
+}
+
+// distortion returns the distortion of a Recommendation for the intent.
+// Systems with an angular distortion over 1e-6 radians are not conformal.
+func distortion(rec Recommendation, intent Intent) float64 {
+	switch intent {
+	case Conformal:
+		if rec.Angle > 1e-6 {
+			return 1 + rec.Scale
+		}
+
+		return rec.Scale
+	case EqualArea:
+		return rec.AreaScale + rec.Angle/1000
+	case LocalAccuracy:
+	}
+
+	return rec.Scale
+}
+
+// datumCost prefers regional datums over global datums and datums without
+// transformations over legacy datums.
+func datumCost(d Datum, sample [2]float64) float64 {
+	cost := 0.0
+
+	if d.Transformation != nil {
+		cost += 2e-4
+	}
+
+	if d.Contains(wrapLon(sample[0]+180), -sample[1]) {
+		cost += 2e-5
+	}
+
+	return cost
+}
+
+// tissot returns the semi-axes of the Tissot indicatrix of a Projection by
+// numerical derivatives.
+func tissot(p Projection, s Spheroid, lon, lat float64) (a, b float64) {
+	const d = 1e-5
+
+	lat = math.Max(-89.9, math.Min(89.9, lat))
+	el := Ellipsoid{Spheroid: s}
+
+	xn, yn := p.FromLonLat(lon, lat+d, s)
+	xs, ys := p.FromLonLat(lon, lat-d, s)
+	xe, ye := p.FromLonLat(lon+d, lat, s)
+	xw, yw := p.FromLonLat(lon-d, lat, s)
+
+	m := el.MeridionalRadius(lat) * radian(2*d)
+	n := el.PrimeVerticalRadius(lat) * math.Cos(radian(lat)) * radian(2*d)
+
+	xφ, yφ := (xn-xs)/m, (yn-ys)/m
+	xλ, yλ := (xe-xw)/n, (ye-yw)/n
+
+	h := math.Hypot(xφ, yφ)
+	k := math.Hypot(xλ, yλ)
+	area := math.Abs(xλ*yφ - xφ*yλ)
+
+	ap := math.Sqrt(math.Max(0, h*h+k*k+2*area))
+	bp := math.Sqrt(math.Max(0, h*h+k*k-2*area))
+
+	return (ap + bp) / 2, (ap - bp) / 2
+}
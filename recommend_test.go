@@ -0,0 +1,64 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestRecommend(t *testing.T) {
+	t.Parallel()
+
+	epsg := wgs84.EPSG()
+
+	recs := epsg.RecommendPoint(9, 52, wgs84.Conformal)
+	if len(recs) < 3 || recs[0].Code != 31467 || recs[1].Code != 25832 || recs[2].Code != 32632 {
+		t.Fatal("Failed", recs)
+	}
+
+	for _, r := range recs {
+		if r.Code == 3035 && r.Cost < 1 {
+			t.Fatal("Failed (2)", r)
+		}
+	}
+
+	recs = epsg.RecommendPoint(9, 52, wgs84.EqualArea)
+	if recs[0].Code != 3035 || recs[0].AreaScale > 1e-6 {
+		t.Fatal("Failed (3)", recs[0])
+	}
+
+	recs = epsg.Recommend(5, 47, 15, 55, wgs84.Conformal)
+	if recs[0].Code != 0 || recs[0].Coverage != 1 || recs[0].Scale > 1e-3 || recs[0].Angle > 1e-6 {
+		t.Fatal("Failed (4)", recs[0])
+	}
+
+	lon, lat, _ := wgs84.Transform(recs[0].System, wgs84.LonLat()).Round(6)(
+		wgs84.Transform(wgs84.LonLat(), recs[0].System)(12, 50, 0),
+	)
+	if lon != 12 || lat != 50 {
+		t.Fatal("Failed (5)", lon, lat)
+	}
+
+	recs = epsg.Recommend(170, -10, -170, 10, wgs84.EqualArea)
+	if recs[0].Code != 0 || recs[0].AreaScale > 1e-6 || !recs[0].System.Contains(180, 0) ||
+		!recs[0].System.Contains(-175, 5) || recs[0].System.Contains(0, 0) {
+		t.Fatal("Failed (6)", recs[0])
+	}
+
+	recs = epsg.Recommend(-120, 30, -70, 50, wgs84.LocalAccuracy)
+	if recs[0].Code != 0 || recs[0].Coverage != 1 || math.Abs(recs[0].Scale) > 0.05 {
+		t.Fatal("Failed (7)", recs[0])
+	}
+
+	recs = epsg.Recommend(179, -44.2, -172, -43.5, wgs84.Conformal)
+	codes := map[int]bool{}
+
+	for _, r := range recs {
+		codes[r.Code] = true
+	}
+
+	if !codes[2193] || !codes[2132] {
+		t.Fatal("Failed (8)", recs)
+	}
+}
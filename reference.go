@@ -3,6 +3,7 @@ package wgs84
 
 import (
 	"errors"
+	"math"
 )
 
 // To provides the transformation of WGS84 geographic coordinates to another
@@ -29,6 +30,18 @@ func LonLat() GeographicReferenceSystem {
 	return WGS84().LonLat()
 }
 
+// RotatedLonLat is a rotated-pole geographic Coordinate Reference System
+// based on the WGS84 Datum.
+func RotatedLonLat(poleLon, poleLat, northPoleGridLon float64) RotatedReferenceSystem {
+	return WGS84().RotatedLonLat(poleLon, poleLat, northPoleGridLon)
+}
+
+// Topocentric is a local tangent plane Coordinate Reference System around an
+// origin on the WGS84 Datum.
+func Topocentric(lon, lat, h float64, axes TopocentricAxes) TopocentricReferenceSystem {
+	return WGS84().Topocentric(lon, lat, h, axes)
+}
+
 // WebMercator is a projected Coordinate Reference System similar to
 // https://epsg.io/3857
 func WebMercator() ProjectedReferenceSystem {
@@ -45,13 +58,10 @@ func UTM(zone float64, northern bool) ProjectedReferenceSystem {
 
 	crs := WGS84().TransverseMercator(zone*6-183, 0, 0.9996, 500000, northf)
 
-	crs.Area = AreaFunc(func(lon, lat float64) bool {
-		if northern {
-			return lon >= zone*6-186 && lon <= zone*6-180 && lat >= 0 && lat <= 84
-		}
-
-		return lon >= zone*6-186 && lon <= zone*6-180 && lat <= 0 && lat >= -80
-	})
+	crs.Area = BoundingBox{West: zone*6 - 186, South: -80, East: zone*6 - 180, North: 0}
+	if northern {
+		crs.Area = BoundingBox{West: zone*6 - 186, South: 0, East: zone*6 - 180, North: 84}
+	}
 
 	return crs
 }
@@ -62,9 +72,7 @@ func UTMNAD83(zone float64) ProjectedReferenceSystem {
 
 	crs := NAD83().TransverseMercator(zone*6-183, 0, 0.9996, 500000, 0)
 
-	crs.Area = AreaFunc(func(lon, lat float64) bool {
-		return lon >= zone*6-186 && lon <= zone*6-180 && lat >= 0 && lat <= 84
-	})
+	crs.Area = BoundingBox{West: zone*6 - 186, South: 0, East: zone*6 - 180, North: 84}
 
 	return crs
 }
@@ -73,9 +81,7 @@ func UTMNAD83(zone float64) ProjectedReferenceSystem {
 // https://epsg.io/25832
 func ETRS89UTM(zone float64) ProjectedReferenceSystem {
 	crs := ETRS89().TransverseMercator(zone*6-183, 0, 0.9996, 500000, 0)
-	crs.Area = AreaFunc(func(lon, lat float64) bool {
-		return lon >= zone*6-186 && lon <= zone*6-180 && lat >= 0 && lat <= 84
-	})
+	crs.Area = BoundingBox{West: zone*6 - 186, South: 0, East: zone*6 - 180, North: 84}
 
 	return crs
 }
@@ -142,9 +148,7 @@ func OSGB36NationalGrid() ProjectedReferenceSystem {
 // https://epsg.io/31467
 func DHDN2001GK(zone float64) ProjectedReferenceSystem {
 	crs := DHDN2001().TransverseMercator(zone*3, 0, 1, zone*1000000+500000, 0)
-	crs.Area = AreaFunc(func(lon, lat float64) bool {
-		return lon >= zone*3-1.5 && lon <= zone*3+1.5 && lat >= 0 && lat <= 84
-	})
+	crs.Area = BoundingBox{West: zone*3 - 1.5, South: 0, East: zone*3 + 1.5, North: 84}
 
 	return crs
 }
@@ -165,9 +169,7 @@ func RGF93FranceLambert() ProjectedReferenceSystem {
 // https://epsg.io/6355
 func NAD83AlabamaEast() ProjectedReferenceSystem {
 	crs := NAD83().TransverseMercator(-85.83333333333333, 30.5, 0.99996, 200000, 0)
-	crs.Area = AreaFunc(func(lon, lat float64) bool {
-		return lon >= -86.79 && lon <= -84.89 && lat >= 30.99 && lat <= 35.0
-	})
+	crs.Area = BoundingBox{West: -86.79, South: 30.99, East: -84.89, North: 35.0}
 
 	return crs
 }
@@ -176,9 +178,7 @@ func NAD83AlabamaEast() ProjectedReferenceSystem {
 // https://epsg.io/6356
 func NAD83AlabamaWest() ProjectedReferenceSystem {
 	crs := NAD83().TransverseMercator(-87.5, 30, 0.999933333, 600000, 0)
-	crs.Area = AreaFunc(func(lon, lat float64) bool {
-		return lon >= -88.48 && lon <= -86.3 && lat >= 30.14 && lat <= 35.02
-	})
+	crs.Area = BoundingBox{West: -88.48, South: 30.14, East: -86.3, North: 35.02}
 
 	return crs
 }
@@ -187,9 +187,7 @@ func NAD83AlabamaWest() ProjectedReferenceSystem {
 // https://epsg.io/6414
 func NAD83CaliforniaAlbers() ProjectedReferenceSystem {
 	crs := NAD83().AlbersEqualAreaConic(34, 40.5, 0, -120, 0, -4000000)
-	crs.Area = AreaFunc(func(lon, lat float64) bool {
-		return lon >= -124.45 && lon <= -114.12 && lat >= 32.53 && lat <= 42.01
-	})
+	crs.Area = BoundingBox{West: -124.45, South: 32.53, East: -114.12, North: 42.01}
 
 	return crs
 }
@@ -200,6 +198,65 @@ func NAD83OntarioMNRlambert() ProjectedReferenceSystem {
 	return NAD83().LambertConformalConic2SP(-85, 0, 44.5, 53.5, 930000, 6430000)
 }
 
+// NZGD49NewZealandMapGrid is a projected Coordinate Reference System similar to
+// https://epsg.io/27200
+func NZGD49NewZealandMapGrid() ProjectedReferenceSystem {
+	return NZGD49().NewZealandMapGrid()
+}
+
+// NZGD2000TransverseMercator is a projected Coordinate Reference System similar to
+// https://epsg.io/2193
+func NZGD2000TransverseMercator() ProjectedReferenceSystem {
+	return NZGD2000().TransverseMercator(173, 0, 0.9996, 1600000, 10000000)
+}
+
+//nolint:gochecknoglobals
+var nzgd2000Circuits = [][3]float64{
+	{174 + 45.0/60 + 51.0/3600, -(36 + 52.0/60 + 47.0/3600), 0.9999},
+	{176 + 27.0/60 + 58.0/3600, -(37 + 45.0/60 + 40.0/3600), 1},
+	{177 + 53.0/60 + 8.0/3600, -(38 + 37.0/60 + 28.0/3600), 1},
+	{176 + 40.0/60 + 25.0/3600, -(39 + 39.0/60 + 3.0/3600), 1},
+	{174 + 13.0/60 + 40.0/3600, -(39 + 8.0/60 + 8.0/3600), 1},
+	{175 + 38.0/60 + 24.0/3600, -(39 + 30.0/60 + 44.0/3600), 1},
+	{175 + 29.0/60 + 17.0/3600, -(40 + 14.0/60 + 31.0/3600), 1},
+	{175 + 38.0/60 + 50.0/3600, -(40 + 55.0/60 + 31.0/3600), 1},
+	{174 + 46.0/60 + 35.0/3600, -(41 + 18.0/60 + 4.0/3600), 1},
+	{172 + 40.0/60 + 19.0/3600, -(40 + 42.0/60 + 53.0/3600), 1},
+	{173 + 17.0/60 + 57.0/3600, -(41 + 16.0/60 + 28.0/3600), 1},
+	{172 + 6.0/60 + 32.0/3600, -(41 + 17.0/60 + 23.0/3600), 1},
+	{171 + 34.0/60 + 52.0/3600, -(41 + 48.0/60 + 38.0/3600), 1},
+	{171 + 32.0/60 + 59.0/3600, -(42 + 20.0/60 + 1.0/3600), 1},
+	{173 + 0.0/60 + 36.0/3600, -(42 + 41.0/60 + 20.0/3600), 1},
+	{173 + 48.0/60 + 7.0/3600, -(41 + 32.0/60 + 40.0/3600), 1},
+	{170 + 58.0/60 + 47.0/3600, -(42 + 53.0/60 + 10.0/3600), 1},
+	{170 + 15.0/60 + 39.0/3600, -(43 + 6.0/60 + 36.0/3600), 1},
+	{168 + 36.0/60 + 22.0/3600, -(43 + 58.0/60 + 40.0/3600), 1},
+	{172 + 43.0/60 + 37.0/3600, -(43 + 35.0/60 + 26.0/3600), 1},
+	{171 + 21.0/60 + 36.0/3600, -(43 + 44.0/60 + 55.0/3600), 1},
+	{171 + 3.0/60 + 26.0/3600, -(44 + 24.0/60 + 7.0/3600), 1},
+	{169 + 28.0/60 + 3.0/3600, -(44 + 44.0/60 + 6.0/3600), 1},
+	{168 + 23.0/60 + 55.0/3600, -(45 + 7.0/60 + 58.0/3600), 1},
+	{167 + 44.0/60 + 19.0/3600, -(45 + 33.0/60 + 49.0/3600), 1},
+	{170 + 37.0/60 + 42.0/3600, -(45 + 48.0/60 + 58.0/3600), 1},
+	{170 + 16.0/60 + 57.0/3600, -(45 + 51.0/60 + 41.0/3600), 0.99996},
+	{168 + 20.0/60 + 34.0/3600, -(46 + 36.0/60 + 0.0/3600), 1},
+}
+
+// NZGD2000Circuit represents the projected Coordinate Reference System's of
+// the 28 meridional circuits similar to https://epsg.io/2105 (circuit 1,
+// Mount Eden 2000) to https://epsg.io/2132 (circuit 28, Bluff 2000).
+//
+// Returns a ProjectedReferenceSystem without Projection for circuits out of range.
+func NZGD2000Circuit(circuit int) ProjectedReferenceSystem {
+	if circuit < 1 || circuit > len(nzgd2000Circuits) {
+		return ProjectedReferenceSystem{}
+	}
+
+	c := nzgd2000Circuits[circuit-1]
+
+	return NZGD2000().TransverseMercator(c[0], c[1], c[2], 400000, 800000)
+}
+
 // GeocentricReferenceSystem represents a geocentric Coordinate Reference System.
 type GeocentricReferenceSystem struct {
 	Datum Datum
@@ -210,6 +267,11 @@ func (crs GeocentricReferenceSystem) Contains(lon, lat float64) bool {
 	return crs.Datum.Contains(lon, lat)
 }
 
+// Body returns the celestial Body of the Datum.
+func (crs GeocentricReferenceSystem) Body() Body {
+	return crs.Datum.Body
+}
+
 // ToWGS84 method is one method of the CoordinateReferenceSystem interface.
 func (crs GeocentricReferenceSystem) ToWGS84(x, y, z float64) (x0, y0, z0 float64) {
 	return crs.Datum.Forward(x, y, z)
@@ -252,6 +314,11 @@ func (crs GeographicReferenceSystem) Contains(lon, lat float64) bool {
 	return crs.Datum.Contains(lon, lat)
 }
 
+// Body returns the celestial Body of the Datum.
+func (crs GeographicReferenceSystem) Body() Body {
+	return crs.Datum.Body
+}
+
 // ToWGS84 method is one method of the CoordinateReferenceSystem interface.
 func (crs GeographicReferenceSystem) ToWGS84(lon, lat, h float64) (x0, y0, z0 float64) {
 	x, y, z := lonLatToXYZ(lon, lat, h, crs.Datum.A(), crs.Datum.Fi())
@@ -288,6 +355,318 @@ func (crs GeographicReferenceSystem) SafeFrom(from CoordinateReferenceSystem) Sa
 	return SafeTransform(from, crs)
 }
 
+// RotatedReferenceSystem represents a rotated-pole geographic Coordinate
+// Reference System as used by regional climate models like CORDEX or COSMO.
+//
+// The grid north pole is located at PoleLon and PoleLat. NorthPoleGridLon is
+// the longitude of the geographic north pole in the rotated grid.
+type RotatedReferenceSystem struct {
+	Datum            Datum
+	PoleLon          float64
+	PoleLat          float64
+	NorthPoleGridLon float64
+	Area             Area
+}
+
+// Contains method is the implementation of the Area interface.
+func (crs RotatedReferenceSystem) Contains(lon, lat float64) bool {
+	return crs.Datum.Contains(lon, lat) && (crs.Area == nil || crs.Area.Contains(lon, lat))
+}
+
+// Body returns the celestial Body of the Datum.
+func (crs RotatedReferenceSystem) Body() Body {
+	return crs.Datum.Body
+}
+
+// ToWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs RotatedReferenceSystem) ToWGS84(rlon, rlat, h float64) (x0, y0, z0 float64) {
+	lon, lat := unrotate(rlon, rlat, crs.PoleLon, crs.PoleLat, crs.NorthPoleGridLon)
+	x, y, z := lonLatToXYZ(lon, lat, h, crs.Datum.A(), crs.Datum.Fi())
+
+	return crs.Datum.Forward(x, y, z)
+}
+
+// FromWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs RotatedReferenceSystem) FromWGS84(x0, y0, z0 float64) (rlon, rlat, h float64) {
+	x, y, z := crs.Datum.Inverse(x0, y0, z0)
+	lon, lat, h := xyzToLonLat(x, y, z, crs.Datum.A(), crs.Datum.Fi())
+	rlon, rlat = rotate(lon, lat, crs.PoleLon, crs.PoleLat, crs.NorthPoleGridLon)
+
+	return rlon, rlat, h
+}
+
+// To provides the transformation to another CoordinateReferenceSystem.
+func (crs RotatedReferenceSystem) To(to CoordinateReferenceSystem) Func {
+	return Transform(crs, to)
+}
+
+// SafeTo provides the transformation to another CoordinateReferenceSystem
+// with errors.
+func (crs RotatedReferenceSystem) SafeTo(to CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(crs, to)
+}
+
+// From provides the transformation from another CoordinateReferenceSystem.
+func (crs RotatedReferenceSystem) From(from CoordinateReferenceSystem) Func {
+	return Transform(from, crs)
+}
+
+// SafeFrom provides the transformation from another CoordinateReferenceSystem
+// with errors.
+func (crs RotatedReferenceSystem) SafeFrom(from CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(from, crs)
+}
+
+// TopocentricAxes specifies the coordinates of a TopocentricReferenceSystem.
+type TopocentricAxes int
+
+const (
+	// EastNorthUp are Cartesian east, north and up coordinates in meters.
+	EastNorthUp TopocentricAxes = iota
+
+	// NorthEastDown are Cartesian north, east and down coordinates in meters.
+	NorthEastDown
+
+	// AzimuthElevationRange are the azimuth clockwise from north and the
+	// elevation above the horizon in degrees and the slant range in meters.
+	AzimuthElevationRange
+)
+
+// TopocentricReferenceSystem represents a local tangent plane Coordinate
+// Reference System around an origin at Lon, Lat and the ellipsoidal height H
+// of the Datum.
+type TopocentricReferenceSystem struct {
+	Datum Datum
+	Lon   float64
+	Lat   float64
+	H     float64
+	Axes  TopocentricAxes
+	Area  Area
+}
+
+// Contains method is the implementation of the Area interface.
+func (crs TopocentricReferenceSystem) Contains(lon, lat float64) bool {
+	return crs.Datum.Contains(lon, lat) && (crs.Area == nil || crs.Area.Contains(lon, lat))
+}
+
+// Body returns the celestial Body of the Datum.
+func (crs TopocentricReferenceSystem) Body() Body {
+	return crs.Datum.Body
+}
+
+// ToWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs TopocentricReferenceSystem) ToWGS84(a, b, c float64) (x0, y0, z0 float64) {
+	east, north, up := a, b, c
+
+	switch crs.Axes {
+	case NorthEastDown:
+		east, north, up = b, a, -c
+	case AzimuthElevationRange:
+		east, north, up = AERToENU(a, b, c)
+	}
+
+	ox, oy, oz := lonLatToXYZ(crs.Lon, crs.Lat, crs.H, crs.Datum.A(), crs.Datum.Fi())
+	sinLon, cosLon := math.Sincos(radian(crs.Lon))
+	sinLat, cosLat := math.Sincos(radian(crs.Lat))
+
+	x := ox - sinLon*east - sinLat*cosLon*north + cosLat*cosLon*up
+	y := oy + cosLon*east - sinLat*sinLon*north + cosLat*sinLon*up
+	z := oz + cosLat*north + sinLat*up
+
+	return crs.Datum.Forward(x, y, z)
+}
+
+// FromWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs TopocentricReferenceSystem) FromWGS84(x0, y0, z0 float64) (a, b, c float64) {
+	x, y, z := crs.Datum.Inverse(x0, y0, z0)
+	ox, oy, oz := lonLatToXYZ(crs.Lon, crs.Lat, crs.H, crs.Datum.A(), crs.Datum.Fi())
+	dx, dy, dz := x-ox, y-oy, z-oz
+	sinLon, cosLon := math.Sincos(radian(crs.Lon))
+	sinLat, cosLat := math.Sincos(radian(crs.Lat))
+
+	east := -sinLon*dx + cosLon*dy
+	north := -sinLat*cosLon*dx - sinLat*sinLon*dy + cosLat*dz
+	up := cosLat*cosLon*dx + cosLat*sinLon*dy + sinLat*dz
+
+	switch crs.Axes {
+	case NorthEastDown:
+		return north, east, -up
+	case AzimuthElevationRange:
+		return ENUToAER(east, north, up)
+	}
+
+	return east, north, up
+}
+
+// To provides the transformation to another CoordinateReferenceSystem.
+func (crs TopocentricReferenceSystem) To(to CoordinateReferenceSystem) Func {
+	return Transform(crs, to)
+}
+
+// SafeTo provides the transformation to another CoordinateReferenceSystem
+// with errors.
+func (crs TopocentricReferenceSystem) SafeTo(to CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(crs, to)
+}
+
+// From provides the transformation from another CoordinateReferenceSystem.
+func (crs TopocentricReferenceSystem) From(from CoordinateReferenceSystem) Func {
+	return Transform(from, crs)
+}
+
+// SafeFrom provides the transformation from another CoordinateReferenceSystem
+// with errors.
+func (crs TopocentricReferenceSystem) SafeFrom(from CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(from, crs)
+}
+
+// ENUToAER converts east, north and up coordinates to the azimuth and
+// elevation in degrees and the slant range.
+//
+// The azimuth is measured clockwise from north between 0 and 360.
+func ENUToAER(east, north, up float64) (azimuth, elevation, slantRange float64) {
+	azimuth = degree(math.Atan2(east, north))
+	if azimuth < 0 {
+		azimuth += 360
+	}
+
+	horizontal := math.Hypot(east, north)
+
+	return azimuth, degree(math.Atan2(up, horizontal)), math.Hypot(horizontal, up)
+}
+
+// AERToENU converts the azimuth and elevation in degrees and the slant range
+// to east, north and up coordinates.
+func AERToENU(azimuth, elevation, slantRange float64) (east, north, up float64) {
+	sinAz, cosAz := math.Sincos(radian(azimuth))
+	sinEl, cosEl := math.Sincos(radian(elevation))
+
+	return slantRange * cosEl * sinAz, slantRange * cosEl * cosAz, slantRange * sinEl
+}
+
+// PlanetocentricReferenceSystem represents a Coordinate Reference System of
+// planetocentric longitudes and latitudes in degrees and the radius from the
+// center of the Body in meters. Longitudes increase to the east.
+type PlanetocentricReferenceSystem struct {
+	Datum Datum
+	Area  Area
+}
+
+// Contains method is the implementation of the Area interface.
+func (crs PlanetocentricReferenceSystem) Contains(lon, lat float64) bool {
+	return crs.Datum.Contains(lon, lat) && (crs.Area == nil || crs.Area.Contains(lon, lat))
+}
+
+// Body returns the celestial Body of the Datum.
+func (crs PlanetocentricReferenceSystem) Body() Body {
+	return crs.Datum.Body
+}
+
+// ToWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs PlanetocentricReferenceSystem) ToWGS84(lon, lat, r float64) (x0, y0, z0 float64) {
+	sinLon, cosLon := math.Sincos(radian(lon))
+	sinLat, cosLat := math.Sincos(radian(lat))
+
+	return crs.Datum.Forward(r*cosLat*cosLon, r*cosLat*sinLon, r*sinLat)
+}
+
+// FromWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs PlanetocentricReferenceSystem) FromWGS84(x0, y0, z0 float64) (lon, lat, r float64) {
+	x, y, z := crs.Datum.Inverse(x0, y0, z0)
+	p := math.Hypot(x, y)
+
+	return degree(math.Atan2(y, x)), degree(math.Atan2(z, p)), math.Hypot(p, z)
+}
+
+// To provides the transformation to another CoordinateReferenceSystem.
+func (crs PlanetocentricReferenceSystem) To(to CoordinateReferenceSystem) Func {
+	return Transform(crs, to)
+}
+
+// SafeTo provides the transformation to another CoordinateReferenceSystem
+// with errors.
+func (crs PlanetocentricReferenceSystem) SafeTo(to CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(crs, to)
+}
+
+// From provides the transformation from another CoordinateReferenceSystem.
+func (crs PlanetocentricReferenceSystem) From(from CoordinateReferenceSystem) Func {
+	return Transform(from, crs)
+}
+
+// SafeFrom provides the transformation from another CoordinateReferenceSystem
+// with errors.
+func (crs PlanetocentricReferenceSystem) SafeFrom(from CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(from, crs)
+}
+
+// PlanetographicReferenceSystem represents a Coordinate Reference System of
+// planetographic longitudes and latitudes in degrees and heights above the
+// Spheroid in meters.
+//
+// Following the IAU conventions longitudes increase to the west between 0
+// and 360 for prograde rotating bodies like Mars, except for the Earth and
+// the Moon.
+type PlanetographicReferenceSystem struct {
+	Datum Datum
+	Area  Area
+}
+
+// Contains method is the implementation of the Area interface.
+func (crs PlanetographicReferenceSystem) Contains(lon, lat float64) bool {
+	return crs.Datum.Contains(lon, lat) && (crs.Area == nil || crs.Area.Contains(lon, lat))
+}
+
+// Body returns the celestial Body of the Datum.
+func (crs PlanetographicReferenceSystem) Body() Body {
+	return crs.Datum.Body
+}
+
+// ToWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs PlanetographicReferenceSystem) ToWGS84(lon, lat, h float64) (x0, y0, z0 float64) {
+	if crs.Datum.Body.westPositive() {
+		lon = -lon
+	}
+
+	x, y, z := lonLatToXYZ(lon, lat, h, crs.Datum.A(), crs.Datum.Fi())
+
+	return crs.Datum.Forward(x, y, z)
+}
+
+// FromWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs PlanetographicReferenceSystem) FromWGS84(x0, y0, z0 float64) (lon, lat, h float64) {
+	x, y, z := crs.Datum.Inverse(x0, y0, z0)
+	lon, lat, h = xyzToLonLat(x, y, z, crs.Datum.A(), crs.Datum.Fi())
+
+	if crs.Datum.Body.westPositive() {
+		lon = math.Mod(360-lon, 360)
+	}
+
+	return lon, lat, h
+}
+
+// To provides the transformation to another CoordinateReferenceSystem.
+func (crs PlanetographicReferenceSystem) To(to CoordinateReferenceSystem) Func {
+	return Transform(crs, to)
+}
+
+// SafeTo provides the transformation to another CoordinateReferenceSystem
+// with errors.
+func (crs PlanetographicReferenceSystem) SafeTo(to CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(crs, to)
+}
+
+// From provides the transformation from another CoordinateReferenceSystem.
+func (crs PlanetographicReferenceSystem) From(from CoordinateReferenceSystem) Func {
+	return Transform(from, crs)
+}
+
+// SafeFrom provides the transformation from another CoordinateReferenceSystem
+// with errors.
+func (crs PlanetographicReferenceSystem) SafeFrom(from CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(from, crs)
+}
+
 // ProjectedReferenceSystem represents a projected Coordinate Reference System.
 type ProjectedReferenceSystem struct {
 	Datum      Datum
@@ -300,6 +679,11 @@ func (crs ProjectedReferenceSystem) Contains(lon, lat float64) bool {
 	return crs.Datum.Contains(lon, lat) && (crs.Area == nil || crs.Area.Contains(lon, lat))
 }
 
+// Body returns the celestial Body of the Datum.
+func (crs ProjectedReferenceSystem) Body() Body {
+	return crs.Datum.Body
+}
+
 // ToWGS84 method is one method of the CoordinateReferenceSystem interface.
 func (crs ProjectedReferenceSystem) ToWGS84(east, north, h float64) (x0, y0, z0 float64) {
 	if crs.Projection == nil {
@@ -347,8 +731,222 @@ func (crs ProjectedReferenceSystem) SafeFrom(from CoordinateReferenceSystem) Saf
 	return SafeTransform(from, crs)
 }
 
+// Local is a local engineering Coordinate Reference System based on this
+// projected Coordinate Reference System.
+func (crs ProjectedReferenceSystem) Local(t PlanarTransformation) LocalReferenceSystem {
+	return LocalReferenceSystem{
+		Base:           crs,
+		Transformation: t,
+	}
+}
+
+// Similarity is a local engineering Coordinate Reference System tied to this
+// projected Coordinate Reference System through a 2D-Similarity-Transformation
+// with the translation tx, ty, the counter-clockwise rotation in degrees and
+// the scale factor. A zero scale returns ErrSingularTransformation.
+func (crs ProjectedReferenceSystem) Similarity(tx, ty, rotation, scale float64) (LocalReferenceSystem, error) {
+	t, err := newSimilarity(tx, ty, rotation, scale)
+	if err != nil {
+		return LocalReferenceSystem{}, err
+	}
+
+	return crs.Local(t), nil
+}
+
+// Affine is a local engineering Coordinate Reference System tied to this
+// projected Coordinate Reference System through a 2D-Affine-Transformation:
+//
+//	east = a0 + a1*x + a2*y
+//	north = b0 + b1*x + b2*y
+//
+// A singular matrix with a1*b2 - a2*b1 = 0 returns ErrSingularTransformation.
+func (crs ProjectedReferenceSystem) Affine(a0, a1, a2, b0, b1, b2 float64) (LocalReferenceSystem, error) {
+	t, err := newAffine(a0, a1, a2, b0, b1, b2)
+	if err != nil {
+		return LocalReferenceSystem{}, err
+	}
+
+	return crs.Local(t), nil
+}
+
+// Polynomial is a local engineering Coordinate Reference System tied to this
+// projected Coordinate Reference System through a 2nd or 3rd order 2D-
+// Polynomial-Transformation. The coefficients a (east) and b (north) belong
+// to the terms 1, x, y, x², xy, y², x³, x²y, xy², y³. Missing coefficients
+// are zero and more than 10 return ErrInvalidPolynomial.
+//
+// The inverse is solved iteratively.
+func (crs ProjectedReferenceSystem) Polynomial(a, b []float64) (LocalReferenceSystem, error) {
+	t, err := newPolynomial(a, b)
+	if err != nil {
+		return LocalReferenceSystem{}, err
+	}
+
+	return crs.Local(t), nil
+}
+
+// LocalReferenceSystem represents a local engineering Coordinate Reference
+// System like a site grid that is tied to a projected Coordinate Reference
+// System through a PlanarTransformation.
+type LocalReferenceSystem struct {
+	Base           ProjectedReferenceSystem
+	Transformation PlanarTransformation
+	Area           Area
+}
+
+// Contains method is the implementation of the Area interface.
+func (crs LocalReferenceSystem) Contains(lon, lat float64) bool {
+	return crs.Base.Contains(lon, lat) && (crs.Area == nil || crs.Area.Contains(lon, lat))
+}
+
+// Body returns the celestial Body of the Datum.
+func (crs LocalReferenceSystem) Body() Body {
+	return crs.Base.Datum.Body
+}
+
+// ToWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs LocalReferenceSystem) ToWGS84(x, y, h float64) (x0, y0, z0 float64) {
+	if crs.Transformation != nil {
+		x, y = crs.Transformation.Forward(x, y)
+	}
+
+	return crs.Base.ToWGS84(x, y, h)
+}
+
+// FromWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs LocalReferenceSystem) FromWGS84(x0, y0, z0 float64) (x, y, h float64) {
+	x, y, h = crs.Base.FromWGS84(x0, y0, z0)
+	if crs.Transformation != nil {
+		x, y = crs.Transformation.Inverse(x, y)
+	}
+
+	return x, y, h
+}
+
+// To provides the transformation to another CoordinateReferenceSystem.
+func (crs LocalReferenceSystem) To(to CoordinateReferenceSystem) Func {
+	return Transform(crs, to)
+}
+
+// SafeTo provides the transformation to another CoordinateReferenceSystem
+// with errors.
+func (crs LocalReferenceSystem) SafeTo(to CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(crs, to)
+}
+
+// From provides the transformation from another CoordinateReferenceSystem.
+func (crs LocalReferenceSystem) From(from CoordinateReferenceSystem) Func {
+	return Transform(from, crs)
+}
+
+// SafeFrom provides the transformation from another CoordinateReferenceSystem
+// with errors.
+func (crs LocalReferenceSystem) SafeFrom(from CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(from, crs)
+}
+
+// CompoundReferenceSystem represents a compound Coordinate Reference System
+// of a horizontal Coordinate Reference System and a VerticalReferenceSystem.
+//
+// The third coordinate is a height of the VerticalReferenceSystem. By default
+// it behaves like the Horizontal Coordinate Reference System.
+type CompoundReferenceSystem struct {
+	Horizontal CoordinateReferenceSystem
+	Vertical   VerticalReferenceSystem
+}
+
+// Contains method is the implementation of the Area interface.
+//
+// The VerticalReferenceSystem is considered if it implements the Area
+// interface.
+func (crs CompoundReferenceSystem) Contains(lon, lat float64) bool {
+	if crs.Horizontal == nil || !crs.Horizontal.Contains(lon, lat) {
+		return false
+	}
+
+	if area, ok := crs.Vertical.(Area); ok {
+		return area.Contains(lon, lat)
+	}
+
+	return true
+}
+
+// Body returns the celestial Body of the Horizontal Coordinate Reference
+// System.
+func (crs CompoundReferenceSystem) Body() Body {
+	if crs.Horizontal == nil {
+		return Earth
+	}
+
+	return bodyOf(crs.Horizontal)
+}
+
+// ToWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs CompoundReferenceSystem) ToWGS84(a, b, height float64) (x0, y0, z0 float64) {
+	if crs.Horizontal == nil {
+		return a, b, height
+	}
+
+	if crs.Vertical == nil {
+		return crs.Horizontal.ToWGS84(a, b, height)
+	}
+
+	x, y, z := crs.Horizontal.ToWGS84(a, b, 0)
+	lon, lat, _ := xyzToLonLat(x, y, z, A, Fi)
+
+	return crs.Horizontal.ToWGS84(a, b, crs.Vertical.ToEllipsoidal(lon, lat, height))
+}
+
+// FromWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs CompoundReferenceSystem) FromWGS84(x0, y0, z0 float64) (a, b, height float64) {
+	if crs.Horizontal == nil {
+		return x0, y0, z0
+	}
+
+	a, b, h := crs.Horizontal.FromWGS84(x0, y0, z0)
+	if crs.Vertical == nil {
+		return a, b, h
+	}
+
+	lon, lat, _ := xyzToLonLat(x0, y0, z0, A, Fi)
+
+	return a, b, crs.Vertical.FromEllipsoidal(lon, lat, h)
+}
+
+// To provides the transformation to another CoordinateReferenceSystem.
+func (crs CompoundReferenceSystem) To(to CoordinateReferenceSystem) Func {
+	return Transform(crs, to)
+}
+
+// SafeTo provides the transformation to another CoordinateReferenceSystem
+// with errors.
+func (crs CompoundReferenceSystem) SafeTo(to CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(crs, to)
+}
+
+// From provides the transformation from another CoordinateReferenceSystem.
+func (crs CompoundReferenceSystem) From(from CoordinateReferenceSystem) Func {
+	return Transform(from, crs)
+}
+
+// SafeFrom provides the transformation from another CoordinateReferenceSystem
+// with errors.
+func (crs CompoundReferenceSystem) SafeFrom(from CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(from, crs)
+}
+
 // Transform provides a transformation between CoordinateReferenceSystems.
+//
+// The constants of the Projections are computed once for all coordinates and
+// systems on the same Spheroid without datum transformations skip the
+// geocentric coordinates.
 func Transform(from, to CoordinateReferenceSystem) Func {
+	from, to = prepare(from), prepare(to)
+
+	if f, ok := direct(from, to); ok {
+		return f
+	}
+
 	return func(a, b, c float64) (a2, b2, c2 float64) {
 		if from != nil {
 			a, b, c = from.ToWGS84(a, b, c)
@@ -367,19 +965,33 @@ var (
 	ErrNoCoordinateReferenceSystem = errors.New("crs not specified")
 	// ErrOutOfBounds is a transformation out of the Area interface boundings.
 	ErrOutOfBounds = errors.New("coordinate is out of bounds")
+	// ErrBodyMismatch is a transformation between different celestial bodies.
+	ErrBodyMismatch = errors.New("crs of different bodies")
+	// ErrInvalidCode is a code that can't be parsed.
+	ErrInvalidCode = errors.New("invalid code")
 )
 
 // SafeTransform provides a transformation between CoordinateReferenceSystems
 // with errors.
 func SafeTransform(from, to CoordinateReferenceSystem) SafeFunc {
+	from, to = prepare(from), prepare(to)
+
 	return func(a, b, c float64) (float64, float64, float64, error) {
 		if from == nil || to == nil {
 			return 0, 0, 0, ErrNoCoordinateReferenceSystem
 		}
 
+		body := bodyOf(from)
+		if body != bodyOf(to) {
+			return 0, 0, 0, ErrBodyMismatch
+		}
+
 		a, b, c = from.ToWGS84(a, b, c)
 
 		lon, lat, _ := xyzToLonLat(a, b, c, A, Fi)
+		if body != Earth {
+			lon, lat, _ = xyzToLonLat(a, b, c, body.A(), body.Fi())
+		}
 		if !from.Contains(lon, lat) || !to.Contains(lon, lat) {
 			return 0, 0, 0, ErrOutOfBounds
 		}
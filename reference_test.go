@@ -0,0 +1,234 @@
+//nolint:varnamelen
+package wgs84_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestRotatedLonLat(t *testing.T) {
+	t.Parallel()
+
+	cosmo := wgs84.RotatedLonLat(-170, 40, 0)
+
+	lon, lat, _ := cosmo.To(wgs84.LonLat()).Round(6)(0, 0, 0)
+	if lon != 10 || lat != 50 {
+		t.Fatal("Failed", lon, lat)
+	}
+
+	rlon, rlat, _ := wgs84.To(cosmo).Round(6)(0, 90, 0)
+	if rlon != 0 || rlat != 40 {
+		t.Fatal("Failed (2)", rlon, rlat)
+	}
+
+	cordex := wgs84.RotatedLonLat(-162, 39.25, 20)
+
+	rlon, rlat, h := wgs84.To(cordex)(15, 45, 100)
+
+	lon, lat, h = cordex.To(wgs84.LonLat()).Round(6)(rlon, rlat, h)
+	if lon != 15 || lat != 45 || h != 100 {
+		t.Fatal("Failed (3)", lon, lat, h)
+	}
+}
+
+func TestTransverseMercator(t *testing.T) {
+	t.Parallel()
+
+	// EPSG Guidance Note 7-2 example for the British National Grid:
+	// E 577274.99 m, N 69740.50 m. The example is rounded from the USGS
+	// series, so the Krüger series is accepted within 1 cm.
+	osgb := wgs84.OSGB36NationalGrid()
+
+	east, north := osgb.Projection.FromLonLat(0.5, 50.5, osgb.Datum)
+	if math.Abs(east-577274.99) > 0.01 || math.Abs(north-69740.50) > 0.01 {
+		t.Fatal("Failed", east, north)
+	}
+
+	epsg := wgs84.EPSG()
+
+	// Reference values of the sixth order Krüger series (Karney 2011, as in
+	// GeographicLib), accepted within 1 mm.
+	for _, c := range []struct {
+		code                  int
+		lon, lat, east, north float64
+	}{
+		{25832, 9, 50, 500000, 5538630.7027},
+		{25832, 12, 50, 714984.2367, 5542944.0185},
+		{32633, 24, 30, 1369825.1581, 3353121.8738},
+		{32759, 175, -40, 841487.4337, 5564573.8284},
+		{31467, 10.5, 51, 3605281.1746, 5652576.6806},
+		{27700, 0.5, 50.5, 577274.9838, 69740.4923},
+		{27700, 1.7, 52.5, 651098.0644, 295598.7830},
+		{27700, -7, 57, 96447.0847, 800971.6827},
+	} {
+		crs, ok := epsg.Code(c.code).(wgs84.ProjectedReferenceSystem)
+		if !ok {
+			t.Fatal("Failed (2)", c.code)
+		}
+
+		east, north := crs.Projection.FromLonLat(c.lon, c.lat, crs.Datum)
+		if math.Abs(east-c.east) > 0.001 || math.Abs(north-c.north) > 0.001 {
+			t.Fatal("Failed (3)", c.code, east-c.east, north-c.north)
+		}
+
+		lon, lat := crs.Projection.ToLonLat(east, north, crs.Datum)
+		if math.Abs(lon-c.lon) > 1e-9 || math.Abs(lat-c.lat) > 1e-9 {
+			t.Fatal("Failed (4)", c.code, lon-c.lon, lat-c.lat)
+		}
+	}
+}
+
+func TestNewZealandMapGrid(t *testing.T) {
+	t.Parallel()
+
+	nzgd49 := wgs84.NZGD49().LonLat()
+
+	east, north, _ := nzgd49.To(wgs84.NZGD49NewZealandMapGrid()).Round(2)(173, -41, 0)
+	if east != 2510000 || north != 6023150 {
+		t.Fatal("Failed", east, north)
+	}
+
+	lon, lat, _ := wgs84.NZGD49NewZealandMapGrid().To(nzgd49).Round(6)(2487100.66, 6751049.75, 0)
+	if lon != 172.739194 || lat != -34.444066 {
+		t.Fatal("Failed (2)", lon, lat)
+	}
+}
+
+func TestLocalReferenceSystem(t *testing.T) {
+	t.Parallel()
+
+	utm := wgs84.ETRS89UTM(32)
+
+	polynomial, err := utm.Polynomial([]float64{500000, 1, 0.01, 1e-6, 2e-6, 0, 1e-9}, []float64{5761000, -0.02, 1, 0, 3e-6, 1e-6})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	quadratic, _ := utm.Polynomial([]float64{500000, 1, 0.01, 1e-6, 2e-6, 0}, []float64{5761000, -0.02, 1, 0, 3e-6, 1e-6})
+
+	east, _ := polynomial.Transformation.Forward(120, 350)
+	e, _ := quadratic.Transformation.Forward(120, 350)
+
+	if math.Abs(east-e-1e-9*120*120*120) > 1e-9 {
+		t.Fatal("Failed", east, e)
+	}
+
+	if _, err := utm.Polynomial(make([]float64, 11), make([]float64, 10)); !errors.Is(err, wgs84.ErrInvalidPolynomial) {
+		t.Fatal("Failed (2)", err)
+	}
+
+	similarity, err := utm.Similarity(500000, 5761000, 12.5, 1.0001)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	affine, err := utm.Affine(500000, 0.9, 0.1, 5761000, -0.05, 1.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := utm.Similarity(500000, 5761000, 12.5, 0); !errors.Is(err, wgs84.ErrSingularTransformation) {
+		t.Fatal("Failed (3)", err)
+	}
+
+	if _, err := utm.Affine(500000, 1, 2, 5761000, 2, 4); !errors.Is(err, wgs84.ErrSingularTransformation) {
+		t.Fatal("Failed (4)", err)
+	}
+
+	for _, local := range []wgs84.LocalReferenceSystem{similarity, affine, polynomial} {
+		epsg := wgs84.EPSG()
+		epsg.Add(100001, local)
+
+		lon, lat, _ := epsg.Transform(100001, 4326)(120, 350, 0)
+		if !local.Contains(lon, lat) {
+			t.Fatal("Failed (5)", lon, lat)
+		}
+
+		x, y, _ := wgs84.To(local).Round(6)(lon, lat, 0)
+		if x != 120 || y != 350 {
+			t.Fatal("Failed (6)", x, y)
+		}
+	}
+}
+
+func TestTopocentric(t *testing.T) {
+	t.Parallel()
+
+	enu := wgs84.Topocentric(10, 50, 100, wgs84.EastNorthUp)
+
+	east, north, up := wgs84.To(enu).Round(3)(10, 50, 200)
+	if east != 0 || north != 0 || up != 100 {
+		t.Fatal("Failed", east, north, up)
+	}
+
+	az, el, r := wgs84.To(wgs84.Topocentric(10, 50, 100, wgs84.AzimuthElevationRange)).Round(3)(10, 50, 200)
+	if el != 90 || r != 100 {
+		t.Fatal("Failed (2)", az, el, r)
+	}
+
+	ned := wgs84.Topocentric(10, 50, 100, wgs84.NorthEastDown)
+
+	north, east, down := wgs84.To(ned)(10.001, 50, 100)
+	if math.Round(north) != 0 || math.Round(east) != 72 || down <= 0 {
+		t.Fatal("Failed (3)", north, east, down)
+	}
+
+	lon, lat, h := wgs84.From(ned).Round(6)(north, east, down)
+	if lon != 10.001 || lat != 50 || h != 100 {
+		t.Fatal("Failed (4)", lon, lat, h)
+	}
+
+	az, el, r = wgs84.ENUToAER(100, -100, 0)
+	if az != 135 || el != 0 {
+		t.Fatal("Failed (5)", az, el, r)
+	}
+
+	east, north, up = wgs84.AERToENU(az, el, r)
+	if math.Abs(east-100) > 1e-9 || math.Abs(north+100) > 1e-9 || math.Abs(up) > 1e-9 {
+		t.Fatal("Failed (6)", east, north, up)
+	}
+
+	osgb := wgs84.OSGB36().Topocentric(-2, 52, 0, wgs84.EastNorthUp)
+
+	east, north, up = wgs84.OSGB36().LonLat().To(osgb).Round(1)(-2, 52, 0)
+	if east != 0 || north != 0 || up != 0 {
+		t.Fatal("Failed (7)", east, north, up)
+	}
+}
+
+func TestPlanetographic(t *testing.T) {
+	t.Parallel()
+
+	ocentric := wgs84.Mars2015().Planetocentric()
+	ographic := wgs84.Mars2015().Planetographic()
+
+	lon, lat, r := ographic.To(ocentric)(10, 45, 0)
+	want := math.Atan(math.Pow(3376200.0/3396190, 2)) * 180 / math.Pi
+
+	if math.Abs(lon+10) > 1e-9 || math.Abs(lat-want) > 1e-9 || r < 3376200 || r > 3396190 {
+		t.Fatal("Failed", lon, lat, r)
+	}
+
+	lon, lat, h := ocentric.To(ographic).Round(6)(lon, lat, r)
+	if lon != 10 || lat != 45 || h != 0 {
+		t.Fatal("Failed (2)", lon, lat, h)
+	}
+
+	if _, _, _, err := ographic.SafeTo(wgs84.LonLat())(10, 45, 0); !errors.Is(err, wgs84.ErrBodyMismatch) {
+		t.Fatal("Failed (3)", err)
+	}
+
+	moon := wgs84.Moon2015().Planetographic()
+
+	lon, lat, h, err := moon.SafeTo(wgs84.Moon2015().Planetocentric()).Round(6)(-30, 20, 0)
+	if err != nil || lon != -30 || lat != 20 || h != 1737400 {
+		t.Fatal("Failed (4)", lon, lat, h, err)
+	}
+
+	if b := wgs84.Mercury.A() - wgs84.Mercury.A()/wgs84.Mercury.Fi(); math.Abs(b-2438260) > 1e-6 {
+		t.Fatal("Failed (5)", b)
+	}
+}
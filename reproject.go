@@ -2,34 +2,45 @@ package wgs84
 
 func ReprojectMap(coords map[int][]float64, fromEpsg, toEpsg int) map[int][]float64 {
 	m := make(map[int][]float64, len(coords))
-	fromCode, toCode := EPSG().Code(fromEpsg), EPSG().Code(toEpsg)
-	for k, cxy := range coords {
-		longitude, latitude, _ := From(fromCode)(cxy[0], cxy[1], 0)
-		m[k] = []float64{longitude, latitude}
+	reproject := reprojectFunc(fromEpsg, toEpsg)
 
-		if toEpsg != 4326 {
-			x, y, _ := To(toCode)(m[k][0], m[k][1], 0)
-			m[k] = []float64{x, y}
-		}
+	for k, cxy := range coords {
+		x, y := reproject(cxy[0], cxy[1])
+		m[k] = []float64{x, y}
 	}
 	return m
 }
 
 func Reproject(x, y float64, fromEpsg, toEpsg int) (float64, float64) {
-	fromCode, toCode := EPSG().Code(fromEpsg), EPSG().Code(toEpsg)
-	longitude, latitude, _ := From(fromCode)(x, y, 0)
-	if toEpsg != 4326 {
-		x2, y2, _ := To(toCode)(longitude, latitude, 0)
-		return x2, y2
-	}
-	return longitude, latitude
+	return reprojectFunc(fromEpsg, toEpsg)(x, y)
 }
 
 func ReprojectSlice(xys [][]float64, fromEpsg, toEpsg int) [][]float64 {
 	xysnew := make([][]float64, len(xys))
+	flat := make([]float64, 2*len(xys))
+	reproject := reprojectFunc(fromEpsg, toEpsg)
+
 	for i, xy := range xys {
-		x, y := Reproject(xy[0], xy[1], fromEpsg, toEpsg)
-		xysnew[i] = []float64{x, y}
+		flat[2*i], flat[2*i+1] = reproject(xy[0], xy[1])
+		xysnew[i] = flat[2*i : 2*i+2 : 2*i+2]
 	}
 	return xysnew
 }
+
+// reprojectFunc looks up the EPSG-Codes once and transforms through
+// geographic WGS84 coordinates.
+func reprojectFunc(fromEpsg, toEpsg int) func(x, y float64) (float64, float64) {
+	epsg := EPSG()
+	from, to := From(epsg.Code(fromEpsg)), To(epsg.Code(toEpsg))
+
+	return func(x, y float64) (float64, float64) {
+		longitude, latitude, _ := from(x, y, 0)
+		if toEpsg == 4326 {
+			return longitude, latitude
+		}
+
+		x2, y2, _ := to(longitude, latitude, 0)
+
+		return x2, y2
+	}
+}
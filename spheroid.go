@@ -122,3 +122,141 @@ func (Clarke1866) A() float64 {
 func (Clarke1866) Fi() float64 {
 	return 294.9786982139006
 }
+
+// International1924 is a spheroid used by several geodetic datums.
+type International1924 struct{}
+
+// A returns the major axis of the spheroid.
+func (International1924) A() float64 {
+	return 6378388
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (International1924) Fi() float64 {
+	return 297
+}
+
+// Krassowsky1940 is a spheroid used by several geodetic datums.
+type Krassowsky1940 struct{}
+
+// A returns the major axis of the spheroid.
+func (Krassowsky1940) A() float64 {
+	return 6378245
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (Krassowsky1940) Fi() float64 {
+	return 298.3
+}
+
+// Clarke1880 is a spheroid used by several geodetic datums.
+//
+// It is the Clarke 1880 (RGS) definition.
+type Clarke1880 struct{}
+
+// A returns the major axis of the spheroid.
+func (Clarke1880) A() float64 {
+	return 6378249.145
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (Clarke1880) Fi() float64 {
+	return 293.465
+}
+
+// Clarke1880IGN is a spheroid used by several geodetic datums.
+type Clarke1880IGN struct{}
+
+// A returns the major axis of the spheroid.
+func (Clarke1880IGN) A() float64 {
+	return 6378249.2
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (Clarke1880IGN) Fi() float64 {
+	return 293.4660212936269
+}
+
+// Everest1830 is a spheroid used by several geodetic datums.
+//
+// It is the Everest 1830 (1937 Adjustment) definition.
+type Everest1830 struct{}
+
+// A returns the major axis of the spheroid.
+func (Everest1830) A() float64 {
+	return 6377276.345
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (Everest1830) Fi() float64 {
+	return 300.8017
+}
+
+// Everest1830Modified is a spheroid used by several geodetic datums.
+type Everest1830Modified struct{}
+
+// A returns the major axis of the spheroid.
+func (Everest1830Modified) A() float64 {
+	return 6377304.063
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (Everest1830Modified) Fi() float64 {
+	return 300.8017
+}
+
+// Everest1967 is a spheroid used by several geodetic datums.
+//
+// It is the Everest 1830 (1967 Definition).
+type Everest1967 struct{}
+
+// A returns the major axis of the spheroid.
+func (Everest1967) A() float64 {
+	return 6377298.556
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (Everest1967) Fi() float64 {
+	return 300.8017
+}
+
+// WGS72Spheroid is the spheroid of the World Geodetic System 1972.
+type WGS72Spheroid struct{}
+
+// A returns the major axis of the spheroid.
+func (WGS72Spheroid) A() float64 {
+	return 6378135
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (WGS72Spheroid) Fi() float64 {
+	return 298.26
+}
+
+// Helmert1906 is a spheroid used by several geodetic datums.
+type Helmert1906 struct{}
+
+// A returns the major axis of the spheroid.
+func (Helmert1906) A() float64 {
+	return 6378200
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (Helmert1906) Fi() float64 {
+	return 298.3
+}
+
+// GRS67Modified is a spheroid used by several geodetic datums.
+//
+// It is the GRS 1967 Modified definition used by the South American Datum 1969.
+type GRS67Modified struct{}
+
+// A returns the major axis of the spheroid.
+func (GRS67Modified) A() float64 {
+	return 6378160
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (GRS67Modified) Fi() float64 {
+	return 298.25
+}
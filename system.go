@@ -3,6 +3,7 @@ package wgs84
 
 import (
 	"math"
+	"math/cmplx"
 )
 
 type webMercator struct{}
@@ -27,62 +28,119 @@ type transverseMercator struct {
 	lonf, latf, scale, eastf, northf float64
 }
 
+// ToLonLat and FromLonLat use the Krüger series in the third flattening
+// (EPSG Guidance Note 7-2, JHS formulas), accurate to the millimeter
+// within several degrees of the central meridian.
 func (p transverseMercator) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
-	sph := spheroid{a: s.A(), fi: s.Fi()}
-	east -= p.eastf
-	north -= p.northf
-	Mi := p._M(radian(p.latf), sph) + north/p.scale
-	μ := Mi / (sph.A() * (1 - sph.e2()/4 - 3*sph.e4()/64 - 5*sph.e6()/256))
-	φ1 := μ + (3*sph.ei()/2-27*sph.ei3()/32)*math.Sin(2*μ) +
-		(21*sph.ei2()/16-55*sph.ei4()/32)*math.Sin(4*μ) +
-		(151*sph.ei3()/96)*math.Sin(6*μ) +
-		(1097*sph.ei4()/512)*math.Sin(8*μ)
-	R1 := sph.A() * (1 - sph.e2()) / math.Pow(1-sph.e2()*sin2(φ1), 3/2)
-	D := east / (p._N(φ1, sph) * p.scale)
-	φ := φ1 - (p._N(φ1, sph)*math.Tan(φ1)/R1)*(D*D/2-(5+3*p._T(φ1)+10*
-		p._C(φ1, sph)-4*p._C(φ1, sph)*p._C(φ1, sph)-9*sph.ei2())*
-		math.Pow(D, 4)/24+(61+90*p._T(φ1)+298*p._C(φ1, sph)+45*p._T(φ1)*
-		p._T(φ1)-252*sph.ei2()-3*p._C(φ1, sph)*p._C(φ1, sph))*
-		math.Pow(D, 6)/720)
-	λ := radian(p.lonf) + (D-(1+2*p._T(φ1)+p._C(φ1, sph))*D*D*D/6+(5-2*p._C(φ1, sph)+
-		28*p._T(φ1)-3*p._C(φ1, sph)*p._C(φ1, sph)+8*sph.ei2()+24*p._T(φ1)*p._T(φ1))*
-		math.Pow(D, 5)/120)/math.Cos(φ1)
-
-	return degree(λ), degree(φ)
+	return p.prepared(s).ToLonLat(east, north, s)
 }
 
 func (p transverseMercator) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	return p.prepared(s).FromLonLat(lon, lat, s)
+}
+
+func (p transverseMercator) prepare(s Spheroid) Projection {
+	return p.prepared(s)
+}
+
+func (p transverseMercator) prepared(s Spheroid) preparedTransverseMercator {
 	sph := spheroid{a: s.A(), fi: s.Fi()}
-	φ := radian(lat)
-	A := (radian(lon) - radian(p.lonf)) * math.Cos(φ)
-	east = p.scale*p._N(φ, sph)*(A+(1-p._T(φ)+p._C(φ, sph))*
-		math.Pow(A, 3)/6+(5-18*p._T(φ)+p._T(φ)*p._T(φ)+72*p._C(φ, sph)-58*sph.ei2())*
-		math.Pow(A, 5)/120) + p.eastf
-	north = p.scale*(p._M(φ, sph)-p._M(radian(p.latf), sph)+p._N(φ, sph)*math.Tan(φ)*
-		(A*A/2+(5-p._T(φ)+9*p._C(φ, sph)+4*p._C(φ, sph)*p._C(φ, sph))*
-			math.Pow(A, 4)/24+(61-58*p._T(φ)+p._T(φ)*p._T(φ)+600*
-			p._C(φ, sph)-330*sph.ei2())*math.Pow(A, 6)/720)) + p.northf
+	n := sph.ei()
+
+	return preparedTransverseMercator{
+		transverseMercator: p,
+		sph:                sph,
+		b:                  p._B(sph),
+		m0:                 p._M0(sph),
+		h:                  p._h(sph),
+		hi: [4]float64{
+			n/2 - 2*n*n/3 + 37*n*n*n/96 - n*n*n*n/360,
+			n*n/48 + n*n*n/15 - 437*n*n*n*n/1440,
+			17*n*n*n/480 - 37*n*n*n*n/840,
+			4397 * n * n * n * n / 161280,
+		},
+	}
+}
+
+// preparedTransverseMercator holds the constants of a transverseMercator for
+// the Spheroid it was prepared for.
+type preparedTransverseMercator struct {
+	transverseMercator
+	sph   spheroid
+	b, m0 float64
+	h, hi [4]float64
+}
+
+func (p preparedTransverseMercator) ToLonLat(east, north float64, _ Spheroid) (lon, lat float64) {
+	η := (east - p.eastf) / (p.b * p.scale)
+	ξ := (north - p.northf + p.scale*p.m0) / (p.b * p.scale)
+	ξ0, η0 := ξ, η
+
+	for k := 1; k <= 4; k++ {
+		ξ0 -= p.hi[k-1] * math.Sin(float64(2*k)*ξ) * math.Cosh(float64(2*k)*η)
+		η0 -= p.hi[k-1] * math.Cos(float64(2*k)*ξ) * math.Sinh(float64(2*k)*η)
+	}
+
+	β := math.Asin(math.Sin(ξ0) / math.Cosh(η0))
+
+	return p.lonf + degree(math.Asin(math.Tanh(η0)/math.Cos(β))), degree(p.sph.invIsometric(math.Asinh(math.Tan(β))))
+}
+
+func (p preparedTransverseMercator) FromLonLat(lon, lat float64, _ Spheroid) (east, north float64) {
+	β := math.Atan(math.Sinh(p._Q(radian(lat), p.sph)))
+	η0 := math.Atanh(math.Cos(β) * math.Sin(radian(lon-p.lonf)))
+	ξ0 := math.Asin(math.Sin(β) * math.Cosh(η0))
+	ξ, η := ξ0, η0
+
+	for k := 1; k <= 4; k++ {
+		ξ += p.h[k-1] * math.Sin(float64(2*k)*ξ0) * math.Cosh(float64(2*k)*η0)
+		η += p.h[k-1] * math.Cos(float64(2*k)*ξ0) * math.Sinh(float64(2*k)*η0)
+	}
+
+	east = p.eastf + p.scale*p.b*η
+	north = p.northf + p.scale*(p.b*ξ-p.m0)
 
 	return east, north
 }
 
-func (transverseMercator) _M(φ float64, sph spheroid) float64 {
-	return sph.A() * ((1-sph.e2()/4-3*sph.e4()/64-5*sph.e6()/256)*φ -
-		(3*sph.e2()/8+3*sph.e4()/32+45*sph.e6()/1024)*math.Sin(2*φ) +
-		(15*sph.e4()/256+45*sph.e6()/1024)*math.Sin(4*φ) -
-		(35*sph.e6()/3072)*math.Sin(6*φ))
+func (transverseMercator) _B(sph spheroid) float64 {
+	return sph.rectifyingRadius()
 }
 
-func (transverseMercator) _N(φ float64, sph spheroid) float64 {
-	return sph.A() / math.Sqrt(1-sph.e2()*sin2(φ))
+func (transverseMercator) _h(sph spheroid) [4]float64 {
+	n := sph.ei()
+
+	return [4]float64{
+		n/2 - 2*n*n/3 + 5*n*n*n/16 + 41*n*n*n*n/180,
+		13*n*n/48 - 3*n*n*n/5 + 557*n*n*n*n/1440,
+		61*n*n*n/240 - 103*n*n*n*n/140,
+		49561 * n * n * n * n / 161280,
+	}
 }
 
-func (transverseMercator) _T(φ float64) float64 {
-	return tan2(φ)
+func (transverseMercator) _Q(φ float64, sph spheroid) float64 {
+	return sph.isometric(φ)
 }
 
-func (transverseMercator) _C(φ float64, sph spheroid) float64 {
-	return sph.ei2() * cos2(φ)
+func (p transverseMercator) _M0(sph spheroid) float64 {
+	switch p.latf {
+	case 0:
+		return 0
+	case 90:
+		return p._B(sph) * math.Pi / 2
+	case -90:
+		return -p._B(sph) * math.Pi / 2
+	}
+
+	ξ00 := math.Atan(math.Sinh(p._Q(radian(p.latf), sph)))
+	ξ0 := ξ00
+	h := p._h(sph)
+
+	for k := 1; k <= 4; k++ {
+		ξ0 += h[k-1] * math.Sin(float64(2*k)*ξ00)
+	}
+
+	return p._B(sph) * ξ0
 }
 
 type lambertConformalConic2SP struct {
@@ -90,30 +148,61 @@ type lambertConformalConic2SP struct {
 }
 
 func (p lambertConformalConic2SP) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	return p.prepared(s).ToLonLat(east, north, s)
+}
+
+func (p lambertConformalConic2SP) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	return p.prepared(s).FromLonLat(lon, lat, s)
+}
+
+func (p lambertConformalConic2SP) prepare(s Spheroid) Projection {
+	return p.prepared(s)
+}
+
+func (p lambertConformalConic2SP) prepared(s Spheroid) preparedLambertConformalConic2SP {
 	sph := spheroid{a: s.A(), fi: s.Fi()}
 
-	ρi := math.Sqrt(math.Pow(east-p.eastf, 2) + math.Pow(p._rho(radian(p.latf), sph)-(north-p.northf), 2))
-	if p._n(sph) < 0 {
+	return preparedLambertConformalConic2SP{
+		lambertConformalConic2SP: p,
+		sph:                      sph,
+		n:                        p._n(sph),
+		f:                        p._F(sph),
+		ρ0:                       p._rho(radian(p.latf), sph),
+	}
+}
+
+// preparedLambertConformalConic2SP holds the constants of a
+// lambertConformalConic2SP for the Spheroid it was prepared for.
+type preparedLambertConformalConic2SP struct {
+	lambertConformalConic2SP
+	sph      spheroid
+	n, f, ρ0 float64
+}
+
+func (p preparedLambertConformalConic2SP) ToLonLat(east, north float64, _ Spheroid) (lon, lat float64) {
+	ρi := math.Sqrt(math.Pow(east-p.eastf, 2) + math.Pow(p.ρ0-(north-p.northf), 2))
+	if p.n < 0 {
 		ρi = -ρi
 	}
 
-	ti := math.Pow(ρi/(sph.A()*p._F(sph)), 1/p._n(sph))
+	ti := math.Pow(ρi/(p.sph.A()*p.f), 1/p.n)
+	e := p.sph.e()
 
 	φ := math.Pi/2 - 2*math.Atan(ti)
 	for i := 0; i < 5; i++ {
-		φ = math.Pi/2 - 2*math.Atan(ti*math.Pow((1-sph.e()*math.Sin(φ))/(1+sph.e()*math.Sin(φ)), sph.e()/2))
+		φ = math.Pi/2 - 2*math.Atan(ti*math.Pow((1-e*math.Sin(φ))/(1+e*math.Sin(φ)), e/2))
 	}
 
-	λ := math.Atan((east-p.eastf)/(p._rho(radian(p.latf), sph)-(north-p.northf)))/p._n(sph) + radian(p.lonf)
+	λ := math.Atan((east-p.eastf)/(p.ρ0-(north-p.northf)))/p.n + radian(p.lonf)
 
 	return degree(λ), degree(φ)
 }
 
-func (p lambertConformalConic2SP) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
-	sph := spheroid{a: s.A(), fi: s.Fi()}
-	θ := p._n(sph) * (radian(lon) - radian(p.lonf))
-	east = p.eastf + p._rho(radian(lat), sph)*math.Sin(θ)
-	north = p.northf + p._rho(radian(p.latf), sph) - p._rho(radian(lat), sph)*math.Cos(θ)
+func (p preparedLambertConformalConic2SP) FromLonLat(lon, lat float64, _ Spheroid) (east, north float64) {
+	θ := p.n * (radian(lon) - radian(p.lonf))
+	ρ := p.sph.A() * p.f * math.Pow(p._t(radian(lat), p.sph), p.n)
+	east = p.eastf + ρ*math.Sin(θ)
+	north = p.northf + p.ρ0 - ρ*math.Cos(θ)
 
 	return east, north
 }
@@ -154,14 +243,7 @@ func (p albersEqualAreaConic) ToLonLat(east, north float64, s Spheroid) (lon, la
 	north -= p.northf
 	ρi := math.Sqrt(east*east + math.Pow(p._rho(radian(p.latf), sph)-north, 2))
 	qi := (p._C(sph) - ρi*ρi*p._n(sph)*p._n(sph)/sph.a2()) / p._n(sph)
-	φ := math.Asin(qi / 2)
-
-	for i := 0; i < 5; i++ {
-		φ += math.Pow(1-sph.e2()*sin2(φ), 2) /
-			(2 * math.Cos(φ)) * (qi/(1-sph.e2()) -
-			math.Sin(φ)/(1-sph.e2()*sin2(φ)) +
-			1/(2*sph.e())*math.Log((1-sph.e()*math.Sin(φ))/(1+sph.e()*math.Sin(φ))))
-	}
+	φ := sph.fromQ(qi)
 
 	θ := math.Atan(east / (p._rho(radian(p.latf), sph) - north))
 
@@ -182,8 +264,7 @@ func (p albersEqualAreaConic) _m(φ float64, sph spheroid) float64 {
 }
 
 func (p albersEqualAreaConic) _q(φ float64, sph spheroid) float64 {
-	return (1 - sph.e2()) * (math.Sin(φ)/(1-sph.e2()*sin2(φ)) -
-		(1/(2*sph.e()))*math.Log((1-sph.e()*math.Sin(φ))/(1+sph.e()*math.Sin(φ))))
+	return sph.q(φ)
 }
 
 func (p albersEqualAreaConic) _n(sph spheroid) float64 {
@@ -220,16 +301,7 @@ func (p lambertAzimuthalEqualArea) ToLonLat(east, north float64, s Spheroid) (lo
 		betaI *= -1
 	}
 
-	rlat := betaI +
-		((math.Pow(sph.e(), 2.0)/3.0 +
-			31*math.Pow(sph.e(), 4.0)/180.0 +
-			517*math.Pow(sph.e(), 6.0)/5040.0) *
-			math.Sin(2*betaI)) +
-		((23*math.Pow(sph.e(), 4.0)/360.0 +
-			251*math.Pow(sph.e(), 6.0)/3780.0) *
-			math.Sin(4*betaI)) +
-		((761 * math.Pow(sph.e(), 6.0) / 45360.0) *
-			math.Sin(6*betaI))
+	rlat := sph.fromQ(p._qp(sph) * math.Sin(betaI))
 
 	lon = p.lonf +
 		degree(math.Atan2((east-p.eastf)*math.Sin(C),
@@ -253,18 +325,15 @@ func (p lambertAzimuthalEqualArea) FromLonLat(lon, lat float64, s Spheroid) (eas
 }
 
 func (p lambertAzimuthalEqualArea) _q(lat float64, sph spheroid) float64 {
-	return (1 - sph.e2()) *
-		((math.Sin(radian(lat)) / (1 - sph.e2()*sin2(radian(lat)))) -
-			((1 / (2 * sph.e())) * math.Log((1-sph.e()*math.Sin(radian(lat)))/(1+sph.e()*math.Sin(radian(lat))))))
+	return sph.q(radian(lat))
 }
 
 func (p lambertAzimuthalEqualArea) _qp(sph spheroid) float64 {
-	return (1 - sph.e2()) * ((1 / (1 - sph.e2())) - ((1 / (2 * sph.e())) * math.Log((1-sph.e())/(1+sph.e()))))
+	return sph.qp()
 }
 
 func (p lambertAzimuthalEqualArea) _q0(sph spheroid) float64 {
-	return (1 - sph.e2()) * ((math.Sin(radian(p.latf)) / (1 - sph.e2()*sin2(radian(p.latf)))) -
-		((1 / (2 * sph.e())) * math.Log((1-sph.e()*math.Sin(radian(p.latf)))/(1+sph.e()*math.Sin(radian(p.latf))))))
+	return sph.q(radian(p.latf))
 }
 
 func (p lambertAzimuthalEqualArea) _beta0(sph spheroid) float64 {
@@ -279,3 +348,78 @@ func (p lambertAzimuthalEqualArea) _D(sph spheroid) float64 {
 	return sph.A() * (math.Cos(radian(p.latf)) / math.Sqrt(1-sph.e2()*math.Pow(math.Sin(radian(p.latf)), 2))) /
 		(p._Rq(sph) * math.Cos(p._beta0(sph)))
 }
+
+type newZealandMapGrid struct {
+	lonf, latf, eastf, northf float64
+}
+
+//nolint:gochecknoglobals
+var (
+	nzmgA = []float64{
+		0.6399175073, -0.1358797613, 0.063294409, -0.02526853, 0.0117879,
+		-0.0055161, 0.0026906, -0.001333, 0.00067, -0.00034,
+	}
+	nzmgB = []complex128{
+		complex(0.7557853228, 0), complex(0.249204646, 0.003371507),
+		complex(-0.001541739, 0.04105856), complex(-0.10162907, 0.01727609),
+		complex(-0.26623489, -0.36249218), complex(-0.6870983, -1.1651967),
+	}
+	nzmgC = []complex128{
+		complex(1.3231270439, 0), complex(-0.577245789, -0.007809598),
+		complex(0.508307513, -0.112208952), complex(-0.15094762, 0.18200602),
+		complex(1.01418179, 1.64497696), complex(1.9660549, 2.5127645),
+	}
+	nzmgD = []float64{
+		1.5627014243, 0.5185406398, -0.03333098, -0.1052906, -0.0368594,
+		0.007317, 0.0122, 0.00394, -0.0013,
+	}
+)
+
+func (p newZealandMapGrid) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	z := complex(north-p.northf, east-p.eastf) / complex(s.A(), 0)
+
+	ζ := complex(0, 0)
+	for i := len(nzmgC) - 1; i >= 0; i-- {
+		ζ = (ζ + nzmgC[i]) * z
+	}
+
+	for i := 0; i < 2; i++ {
+		num, den := z, complex(0, 0)
+		for k := len(nzmgB); k >= 1; k-- {
+			if k > 1 {
+				num += complex(float64(k-1), 0) * nzmgB[k-1] * cmplx.Pow(ζ, complex(float64(k), 0))
+			}
+
+			den += complex(float64(k), 0) * nzmgB[k-1] * cmplx.Pow(ζ, complex(float64(k-1), 0))
+		}
+
+		ζ = num / den
+	}
+
+	dψ := real(ζ)
+	dφ := 0.0
+
+	for i := len(nzmgD) - 1; i >= 0; i-- {
+		dφ = (dφ + nzmgD[i]) * dψ
+	}
+
+	return p.lonf + degree(imag(ζ)), p.latf + dφ*100000/3600
+}
+
+func (p newZealandMapGrid) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	dφ := (lat - p.latf) * 3600 / 100000
+	dψ := 0.0
+
+	for i := len(nzmgA) - 1; i >= 0; i-- {
+		dψ = (dψ + nzmgA[i]) * dφ
+	}
+
+	ζ := complex(dψ, radian(lon-p.lonf))
+	z := complex(0, 0)
+
+	for i := len(nzmgB) - 1; i >= 0; i-- {
+		z = (z + nzmgB[i]) * ζ
+	}
+
+	return p.eastf + s.A()*imag(z), p.northf + s.A()*real(z)
+}
@@ -32,19 +32,142 @@ func lonLatToXYZ(lon, lat, h, a, fi float64) (x, y, z float64) {
 	return x, y, z
 }
 
+// xyzToLonLat converts geocentric to geodetic coordinates by the closed-form
+// solution of Vermeille (2011) in the formulation of Karney
+// (GeographicLib), which is accurate at all heights including the polar axis
+// and the center.
 func xyzToLonLat(x, y, z, a, fi float64) (lon, lat, h float64) {
 	s := spheroid{a: a, fi: fi}
-	sd := math.Sqrt(x*x + y*y)
-	T := math.Atan(z * s.A() / (sd * s.b()))
-	B := math.Atan((z + s.e2()*(s.a2())/s.b()*
-		math.Pow(math.Sin(T), 3)) / (sd - s.e2()*s.A()*math.Pow(math.Cos(T), 3)))
-	h = sd/math.Cos(B) - _N(B, s)
+	R := math.Hypot(x, y)
 	lon = degree(math.Atan2(y, x))
-	lat = degree(B)
 
-	return lon, lat, h
+	e2 := s.e2()
+	if e2 == 0 {
+		return lon, degree(math.Atan2(z, R)), math.Hypot(R, z) - a
+	}
+
+	e4 := e2 * e2
+	e2m := (1 - s.f()) * (1 - s.f())
+	p := (R / a) * (R / a)
+	q := e2m * (z / a) * (z / a)
+	r := (p + q - e4) / 6
+
+	var sinφ, cosφ float64
+
+	if e4*q != 0 || r > 0 {
+		S := e4 * p * q / 4
+		r2 := r * r
+		r3 := r * r2
+		disc := S * (S + 2*r3)
+		u := r
+
+		if disc >= 0 {
+			T3 := S + r3
+			if T3 < 0 {
+				T3 -= math.Sqrt(disc)
+			} else {
+				T3 += math.Sqrt(disc)
+			}
+
+			T := math.Cbrt(T3)
+			u += T
+
+			if T != 0 {
+				u += r2 / T
+			}
+		} else {
+			u += 2 * r * math.Cos(math.Atan2(math.Sqrt(-disc), -(S+r3))/3)
+		}
+
+		v := math.Sqrt(u*u + e4*q)
+
+		uv := u + v
+		if u < 0 {
+			uv = e4 * q / (v - u)
+		}
+
+		w := math.Max(0, e2*(uv-q)/(2*v))
+		k := uv / (math.Sqrt(uv+w*w) + w)
+		d := k * R / (k + e2)
+		H := math.Hypot(z/k, R/(k+e2))
+		sinφ, cosφ = z/k/H, R/(k+e2)/H
+		h = (1 - e2m/k) * math.Hypot(d, z)
+	} else {
+		// on the equatorial plane inside of the evolute
+		zz := math.Sqrt((e4 - p) / e2m)
+		xx := math.Sqrt(p)
+		H := math.Hypot(zz, xx)
+		sinφ, cosφ = zz/H, xx/H
+
+		if z < 0 {
+			sinφ = -sinφ
+		}
+
+		h = -a * e2m * H / e2
+	}
+
+	return lon, degree(math.Atan2(sinφ, cosφ)), h
 }
 
 func _N(φ float64, s spheroid) float64 {
 	return s.A() / math.Sqrt(1-s.e2()*math.Pow(math.Sin(φ), 2))
 }
+
+// rotationAxes returns the unit vectors of the rotated grid origin, its
+// east direction and the grid north pole in geocentric coordinates.
+func rotationAxes(poleLon, poleLat float64) (o, e, p [3]float64) {
+	p = unitVector(poleLon, poleLat)
+	o = unitVector(poleLon+180, 90-poleLat)
+	e = [3]float64{
+		p[1]*o[2] - p[2]*o[1],
+		p[2]*o[0] - p[0]*o[2],
+		p[0]*o[1] - p[1]*o[0],
+	}
+
+	return o, e, p
+}
+
+func unitVector(lon, lat float64) [3]float64 {
+	return [3]float64{
+		math.Cos(radian(lat)) * math.Cos(radian(lon)),
+		math.Cos(radian(lat)) * math.Sin(radian(lon)),
+		math.Sin(radian(lat)),
+	}
+}
+
+func normalizeLon(lon float64) float64 {
+	lon = math.Mod(lon+180, 360)
+	if lon < 0 {
+		lon += 360
+	}
+
+	return lon - 180
+}
+
+func rotate(lon, lat, poleLon, poleLat, northPoleGridLon float64) (rlon, rlat float64) {
+	o, e, p := rotationAxes(poleLon, poleLat)
+	v := unitVector(lon, lat)
+	dot := func(a [3]float64) float64 {
+		return a[0]*v[0] + a[1]*v[1] + a[2]*v[2]
+	}
+
+	rlat = degree(math.Asin(math.Max(-1, math.Min(1, dot(p)))))
+	rlon = normalizeLon(degree(math.Atan2(dot(e), dot(o))) + northPoleGridLon)
+
+	return rlon, rlat
+}
+
+func unrotate(rlon, rlat, poleLon, poleLat, northPoleGridLon float64) (lon, lat float64) {
+	o, e, p := rotationAxes(poleLon, poleLat)
+	r := unitVector(rlon-northPoleGridLon, rlat)
+
+	var v [3]float64
+	for i := range v {
+		v[i] = r[0]*o[i] + r[1]*e[i] + r[2]*p[i]
+	}
+
+	lat = degree(math.Asin(math.Max(-1, math.Min(1, v[2]))))
+	lon = degree(math.Atan2(v[1], v[0]))
+
+	return lon, lat
+}
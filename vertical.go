@@ -0,0 +1,96 @@
+//nolint:varnamelen,nonamedreturns
+package wgs84
+
+import "math"
+
+// VerticalDatum represents a gravity-related vertical datum like NAVD88,
+// EVRF2007, ODN or DHHN2016 through a Geoid model of its reference surface,
+// for example a GeoidGrid.
+//
+// Orthometric heights are converted to ellipsoidal heights by adding the
+// geoid undulation and the Offset.
+//
+// It implements the VerticalReferenceSystem and Area interface.
+type VerticalDatum struct {
+	Geoid  Geoid
+	Offset float64
+	Area   Area
+}
+
+// Contains method is the implementation of the Area interface.
+//
+// Returns false outside of the Geoid.
+func (v VerticalDatum) Contains(lon, lat float64) bool {
+	return (v.Area == nil || v.Area.Contains(lon, lat)) && !math.IsNaN(v.undulation(lon, lat))
+}
+
+// ToEllipsoidal converts orthometric heights to ellipsoidal heights.
+func (v VerticalDatum) ToEllipsoidal(lon, lat, height float64) (h float64) {
+	return height + v.undulation(lon, lat) + v.Offset
+}
+
+// FromEllipsoidal converts ellipsoidal heights to orthometric heights.
+func (v VerticalDatum) FromEllipsoidal(lon, lat, h float64) (height float64) {
+	return h - v.undulation(lon, lat) - v.Offset
+}
+
+func (v VerticalDatum) undulation(lon, lat float64) float64 {
+	if v.Geoid == nil {
+		return 0
+	}
+
+	return v.Geoid.Undulation(lon, lat)
+}
+
+// verticalError returns the error of loading the GeoidFile of a
+// VerticalDatum.
+func verticalError(v VerticalReferenceSystem) error {
+	if d, ok := v.(VerticalDatum); ok {
+		if g, ok := d.Geoid.(*geoidFile); ok {
+			return g.load()
+		}
+	}
+
+	return nil
+}
+
+// EllipsoidalHeight is a VerticalReferenceSystem of ellipsoidal heights.
+type EllipsoidalHeight struct{}
+
+// ToEllipsoidal returns the height.
+func (EllipsoidalHeight) ToEllipsoidal(_, _, height float64) (h float64) {
+	return height
+}
+
+// FromEllipsoidal returns the height.
+func (EllipsoidalHeight) FromEllipsoidal(_, _, h float64) (height float64) {
+	return h
+}
+
+// VerticalOffset is a VerticalReferenceSystem that differs from a Base
+// VerticalReferenceSystem by a constant Offset. Heights of the Base are
+// the heights of the VerticalOffset plus the Offset.
+//
+// By default the Base is an EllipsoidalHeight.
+type VerticalOffset struct {
+	Base   VerticalReferenceSystem
+	Offset float64
+}
+
+// ToEllipsoidal converts heights to ellipsoidal heights.
+func (v VerticalOffset) ToEllipsoidal(lon, lat, height float64) (h float64) {
+	if v.Base == nil {
+		return height + v.Offset
+	}
+
+	return v.Base.ToEllipsoidal(lon, lat, height+v.Offset)
+}
+
+// FromEllipsoidal converts ellipsoidal heights to heights.
+func (v VerticalOffset) FromEllipsoidal(lon, lat, h float64) (height float64) {
+	if v.Base != nil {
+		h = v.Base.FromEllipsoidal(lon, lat, h)
+	}
+
+	return h - v.Offset
+}